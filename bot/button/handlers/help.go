@@ -0,0 +1,62 @@
+package handlers
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/rxdn/gdl/objects/channel/embed"
+)
+
+// HelpPageHandler switches between the pages of the /help command list
+type HelpPageHandler struct {
+	Registry cmdregistry.Registry
+}
+
+func (h *HelpPageHandler) Matcher() matcher.Matcher {
+	return &matcher.FuncMatcher{
+		Func: func(customId string) bool {
+			return strings.HasPrefix(customId, "help_")
+		},
+	}
+}
+
+func (h *HelpPageHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:   registry.SumFlags(registry.GuildAllowed, registry.CanEdit),
+		Timeout: time.Second * 5,
+	}
+}
+
+var helpPagePattern = regexp.MustCompile(`help_(\d+)`)
+
+func (h *HelpPageHandler) Execute(ctx *context.ButtonContext) {
+	groups := helpPagePattern.FindStringSubmatch(ctx.InteractionData.CustomId)
+	if len(groups) < 2 {
+		return
+	}
+
+	page, err := strconv.Atoi(groups[1])
+	if err != nil {
+		return
+	}
+
+	// The commands shown depend on the user's permissions, which may have changed since the list was sent
+	msgEmbed, components, err := logic.BuildHelpPage(ctx.Context, ctx, h.Registry, page)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Edit(command.MessageResponse{
+		Embeds:     []*embed.Embed{msgEmbed},
+		Components: components,
+	})
+}
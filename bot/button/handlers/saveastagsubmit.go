@@ -0,0 +1,63 @@
+package handlers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/tags"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+)
+
+type SaveAsTagSubmitHandler struct{}
+
+func (h *SaveAsTagSubmitHandler) Matcher() matcher.Matcher {
+	return matcher.NewSimpleMatcher(tags.SaveAsTagModalId)
+}
+
+func (h *SaveAsTagSubmitHandler) Properties() registry.Properties {
+	return registry.Properties{
+		Flags:           registry.SumFlags(registry.GuildAllowed),
+		PermissionLevel: permission.Support,
+		Timeout:         time.Second * 3,
+	}
+}
+
+func (h *SaveAsTagSubmitHandler) Execute(ctx *context.ModalContext) {
+	tagId, ok := ctx.GetInput("id")
+	if !ok {
+		ctx.HandleError(fmt.Errorf("Modal missing id input"))
+		return
+	}
+
+	content, ok := ctx.GetInput("content")
+	if !ok {
+		ctx.HandleError(fmt.Errorf("Modal missing content input"))
+		return
+	}
+
+	tagId = strings.TrimSpace(tagId)
+	if len(tagId) == 0 || utf8.RuneCountInString(tagId) > 16 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageTagCreateTooLong)
+		return
+	}
+
+	if len(strings.TrimSpace(content)) == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSaveAsTagEmpty)
+		return
+	}
+
+	// This must be malicious
+	if utf8.RuneCountInString(content) > tags.SaveAsTagMaxLength {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageTagCreateInvalidArguments)
+		return
+	}
+
+	tags.ManageTagsAddCommand{}.Execute(ctx, tagId, content)
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
 	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
 	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/cooldown"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/tracing"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/objects/interaction/component"
@@ -81,7 +83,7 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 			return false
 		}
 
-		shouldExecute, canEdit := doPropertiesChecks(checkCtx, data.GuildId.Value, cc, handler.Properties())
+		shouldExecute, canEdit := doPropertiesChecks(checkCtx, data.GuildId.Value, cc, handlerName(handler), handler.Properties())
 		if shouldExecute {
 			go func() {
 				defer close(responseCh)
@@ -103,7 +105,7 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 			return false
 		}
 
-		shouldExecute, canEdit := doPropertiesChecks(checkCtx, data.GuildId.Value, cc, handler.Properties())
+		shouldExecute, canEdit := doPropertiesChecks(checkCtx, data.GuildId.Value, cc, handlerName(handler), handler.Properties())
 		if shouldExecute {
 			go func() {
 				defer close(responseCh)
@@ -125,18 +127,25 @@ func HandleInteraction(ctx context.Context, manager *ComponentInteractionManager
 	}
 }
 
-func doPropertiesChecks(ctx context.Context, guildId uint64, cmd cmdregistry.CommandContext, properties registry.Properties) (shouldExecute, canEdit bool) {
-	if properties.PermissionLevel > permission.Everyone {
-		permLevel, err := cmd.UserPermissionLevel(ctx)
+func doPropertiesChecks(ctx context.Context, guildId uint64, cmd cmdregistry.CommandContext, name string, properties registry.Properties) (shouldExecute, canEdit bool) {
+	// The permission level is also needed to check whether the user bypasses the cooldown
+	permLevel := permission.Everyone
+	if properties.PermissionLevel > permission.Everyone || properties.Cooldown != nil {
+		spanCtx, span := tracing.Tracer.Start(ctx, "permission.GetPermissionLevel")
+		res, err := cmd.UserPermissionLevel(spanCtx)
+		span.End()
+
 		if err != nil {
 			fmt.Print(err, cmd.ToErrorContext())
 			return false, false
 		}
 
-		if permLevel < properties.PermissionLevel {
-			cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
-			return false, false
-		}
+		permLevel = res
+	}
+
+	if permLevel < properties.PermissionLevel {
+		cmd.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
+		return false, false
 	}
 
 	if guildId == 0 && !properties.HasFlag(registry.DMsAllowed) {
@@ -149,5 +158,14 @@ func doPropertiesChecks(ctx context.Context, guildId uint64, cmd cmdregistry.Com
 		return false, false
 	}
 
+	if !cooldown.Take(cmd, name, properties.Cooldown, permLevel) {
+		return false, false
+	}
+
 	return true, properties.HasFlag(registry.CanEdit)
 }
+
+// handlerName identifies the handler in cooldown keys, as handlers do not have a name of their own
+func handlerName(handler any) string {
+	return fmt.Sprintf("%T", handler)
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/handlers"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button/registry/matcher"
+	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 )
 
 type ComponentInteractionManager struct {
@@ -47,7 +48,9 @@ func (m *ComponentInteractionManager) GetCommands() []registry.ButtonHandler {
 	return m.buttonRegistry
 }
 
-func (m *ComponentInteractionManager) RegisterCommands() {
+// RegisterCommands registers the component handlers. Some handlers, such as the /help pages, need the registry of
+// application commands.
+func (m *ComponentInteractionManager) RegisterCommands(commands cmdregistry.Registry) {
 	m.buttonRegistry = append(m.buttonRegistry,
 		new(handlers.AddAdminHandler),
 		new(handlers.AddSupportHandler),
@@ -57,6 +60,7 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 		new(handlers.CloseConfirmHandler),
 		new(handlers.CloseRequestAcceptHandler),
 		new(handlers.CloseRequestDenyHandler),
+		&handlers.HelpPageHandler{Registry: commands},
 		new(handlers.JoinThreadHandler),
 		new(handlers.OpenSurveyHandler),
 		new(handlers.PanelHandler),
@@ -74,6 +78,7 @@ func (m *ComponentInteractionManager) RegisterCommands() {
 		new(handlers.FormHandler),
 		new(handlers.CloseWithReasonSubmitHandler),
 		new(handlers.ExitSurveySubmitHandler),
+		new(handlers.SaveAsTagSubmitHandler),
 	)
 
 	for _, handler := range m.buttonRegistry {
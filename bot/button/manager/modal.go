@@ -31,7 +31,7 @@ func HandleModalInteraction(ctx context.Context, manager *ComponentInteractionMa
 	ctx, cancel := context.WithTimeout(ctx, handler.Properties().Timeout)
 
 	cc := cmdcontext.NewModalContext(ctx, worker, data, responseCh)
-	shouldExecute, canEdit := doPropertiesChecks(lookupCtx, data.GuildId.Value, cc, handler.Properties())
+	shouldExecute, canEdit := doPropertiesChecks(lookupCtx, data.GuildId.Value, cc, handlerName(handler), handler.Properties())
 	if shouldExecute {
 		go func() {
 			defer cancel()
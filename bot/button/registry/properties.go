@@ -2,6 +2,7 @@ package registry
 
 import (
 	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	"time"
 )
 
@@ -9,6 +10,7 @@ type Properties struct {
 	Flags           int
 	PermissionLevel permission.PermissionLevel
 	Timeout         time.Duration
+	Cooldown        *command.Cooldown
 }
 
 func (p *Properties) HasFlag(flag Flag) bool {
@@ -1,22 +1,46 @@
 package command
 
 import (
+	"fmt"
+	"strconv"
+	"unicode/utf8"
+
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects"
+	"github.com/rxdn/gdl/objects/channel"
 	"github.com/rxdn/gdl/objects/interaction"
 )
 
 type Argument struct {
 	Name                string
-	Description         string
+	Description         i18n.MessageId
 	Type                interaction.ApplicationCommandOptionType
 	Required            bool
 	InvalidMessage      i18n.MessageId
 	AutoCompleteHandler AutoCompleteHandler
+
+	// Constraints are sent to Discord when the command is registered, and checked again when the command is run, in
+	// case the registration is outdated
+	Choices      []interaction.ApplicationCommandOptionChoice
+	MinValue     *float64 // Integer and number arguments only
+	MaxValue     *float64 // Integer and number arguments only
+	MinLength    *int     // String arguments only
+	MaxLength    *int     // String arguments only
+	ChannelTypes []channel.ChannelType
+}
+
+// InvalidArgumentError is returned when the value of an argument does not satisfy its constraints. The argument's
+// InvalidMessage should be sent to the user.
+type InvalidArgumentError struct {
+	Argument Argument
+	Reason   string
 }
 
-type AutoCompleteHandler func(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice
+func (e *InvalidArgumentError) Error() string {
+	return fmt.Sprintf("argument %s is invalid: %s", e.Argument.Name, e.Reason)
+}
 
-func NewOptionalArgument(name, description string, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId) Argument {
+func NewOptionalArgument(name string, description i18n.MessageId, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId) Argument {
 	return Argument{
 		Name:                name,
 		Description:         description,
@@ -27,7 +51,7 @@ func NewOptionalArgument(name, description string, argumentType interaction.Appl
 	}
 }
 
-func NewRequiredArgument(name, description string, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId) Argument {
+func NewRequiredArgument(name string, description i18n.MessageId, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId) Argument {
 	return Argument{
 		Name:                name,
 		Description:         description,
@@ -38,7 +62,7 @@ func NewRequiredArgument(name, description string, argumentType interaction.Appl
 	}
 }
 
-func NewOptionalAutocompleteableArgument(name, description string, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId, autoCompleteHandler AutoCompleteHandler) Argument {
+func NewOptionalAutocompleteableArgument(name string, description i18n.MessageId, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId, autoCompleteHandler AutoCompleteHandler) Argument {
 	return Argument{
 		Name:                name,
 		Description:         description,
@@ -49,7 +73,7 @@ func NewOptionalAutocompleteableArgument(name, description string, argumentType
 	}
 }
 
-func NewRequiredAutocompleteableArgument(name, description string, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId, autoCompleteHandler AutoCompleteHandler) Argument {
+func NewRequiredAutocompleteableArgument(name string, description i18n.MessageId, argumentType interaction.ApplicationCommandOptionType, invalidMessage i18n.MessageId, autoCompleteHandler AutoCompleteHandler) Argument {
 	return Argument{
 		Name:                name,
 		Description:         description,
@@ -60,6 +84,136 @@ func NewRequiredAutocompleteableArgument(name, description string, argumentType
 	}
 }
 
+func (a Argument) WithChoices(choices ...interaction.ApplicationCommandOptionChoice) Argument {
+	a.Choices = choices
+	return a
+}
+
+func (a Argument) WithMinValue(min float64) Argument {
+	a.MinValue = &min
+	return a
+}
+
+func (a Argument) WithMaxValue(max float64) Argument {
+	a.MaxValue = &max
+	return a
+}
+
+func (a Argument) WithMinLength(min int) Argument {
+	a.MinLength = &min
+	return a
+}
+
+func (a Argument) WithMaxLength(max int) Argument {
+	a.MaxLength = &max
+	return a
+}
+
+func (a Argument) WithChannelTypes(channelTypes ...channel.ChannelType) Argument {
+	a.ChannelTypes = channelTypes
+	return a
+}
+
+// HasConstraints returns true if the value of the argument must be validated
+func (a Argument) HasConstraints() bool {
+	return len(a.Choices) > 0 || a.MinValue != nil || a.MaxValue != nil || a.MinLength != nil || a.MaxLength != nil ||
+		len(a.ChannelTypes) > 0
+}
+
+// Validate checks the raw value of the option received from Discord against the argument's constraints. Channels are
+// looked up in the resolved data to check their type.
+func (a Argument) Validate(value any, resolved interaction.ResolvedData) error {
+	if len(a.Choices) > 0 {
+		var found bool
+		for _, choice := range a.Choices {
+			if choiceEquals(choice.Value, value) {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return a.invalid("value %v is not one of the choices", value)
+		}
+	}
+
+	if number, ok := value.(float64); ok {
+		if a.MinValue != nil && number < *a.MinValue {
+			return a.invalid("value %v is less than %v", number, *a.MinValue)
+		}
+
+		if a.MaxValue != nil && number > *a.MaxValue {
+			return a.invalid("value %v is greater than %v", number, *a.MaxValue)
+		}
+	}
+
+	if a.Type == interaction.OptionTypeString {
+		s, _ := value.(string)
+		length := utf8.RuneCountInString(s)
+
+		if a.MinLength != nil && length < *a.MinLength {
+			return a.invalid("length %d is less than %d", length, *a.MinLength)
+		}
+
+		if a.MaxLength != nil && length > *a.MaxLength {
+			return a.invalid("length %d is greater than %d", length, *a.MaxLength)
+		}
+	}
+
+	if a.Type == interaction.OptionTypeChannel && len(a.ChannelTypes) > 0 {
+		raw, _ := value.(string)
+		channelId, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return a.invalid("value %v is not a snowflake", value)
+		}
+
+		ch, ok := resolved.Channels[objects.Snowflake(channelId)]
+		if !ok {
+			return a.invalid("channel %d was not resolved", channelId)
+		}
+
+		var allowed bool
+		for _, channelType := range a.ChannelTypes {
+			if ch.Type == channelType {
+				allowed = true
+				break
+			}
+		}
+
+		if !allowed {
+			return a.invalid("channel type %d is not allowed", ch.Type)
+		}
+	}
+
+	return nil
+}
+
+func (a Argument) invalid(format string, args ...any) error {
+	return &InvalidArgumentError{
+		Argument: a,
+		Reason:   fmt.Sprintf(format, args...),
+	}
+}
+
+// choiceEquals compares a choice to a value received from Discord. Integer choices may be declared with any integer
+// type, whereas received numbers are always float64.
+func choiceEquals(choice, value any) bool {
+	if number, ok := value.(float64); ok {
+		switch c := choice.(type) {
+		case int:
+			return float64(c) == number
+		case int64:
+			return float64(c) == number
+		case uint64:
+			return float64(c) == number
+		case float64:
+			return c == number
+		}
+	}
+
+	return choice == value
+}
+
 func Arguments(argument ...Argument) []Argument {
 	return argument
 }
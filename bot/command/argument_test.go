@@ -0,0 +1,54 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/rxdn/gdl/objects"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMaxLength(t *testing.T) {
+	arg := NewRequiredArgument("id", "", interaction.OptionTypeString, "").WithMaxLength(4)
+
+	require.NoError(t, arg.Validate("tag!", interaction.ResolvedData{}))
+	require.NoError(t, arg.Validate("ñññ", interaction.ResolvedData{}), "length should be counted in characters")
+
+	var invalid *InvalidArgumentError
+	require.ErrorAs(t, arg.Validate("tag id", interaction.ResolvedData{}), &invalid)
+	require.Equal(t, "id", invalid.Argument.Name)
+}
+
+func TestValidateValueRange(t *testing.T) {
+	arg := NewOptionalArgument("hours", "", interaction.OptionTypeInteger, "").WithMinValue(1).WithMaxValue(24)
+
+	require.NoError(t, arg.Validate(float64(24), interaction.ResolvedData{}))
+	require.Error(t, arg.Validate(float64(0), interaction.ResolvedData{}))
+	require.Error(t, arg.Validate(float64(25), interaction.ResolvedData{}))
+}
+
+func TestValidateIntegerChoices(t *testing.T) {
+	arg := NewRequiredArgument("priority", "", interaction.OptionTypeInteger, "").WithChoices(
+		interaction.ApplicationCommandOptionChoice{Name: "Low", Value: 1},
+		interaction.ApplicationCommandOptionChoice{Name: "High", Value: 2},
+	)
+
+	require.NoError(t, arg.Validate(float64(2), interaction.ResolvedData{}))
+	require.Error(t, arg.Validate(float64(3), interaction.ResolvedData{}))
+}
+
+func TestValidateChannelTypes(t *testing.T) {
+	arg := NewRequiredArgument("channel", "", interaction.OptionTypeChannel, "").WithChannelTypes(channel.ChannelTypeGuildText)
+
+	resolved := interaction.ResolvedData{
+		Channels: map[objects.Snowflake]channel.Channel{
+			1: {Id: 1, Type: channel.ChannelTypeGuildText},
+			2: {Id: 2, Type: channel.ChannelTypeGuildVoice},
+		},
+	}
+
+	require.NoError(t, arg.Validate("1", resolved))
+	require.Error(t, arg.Validate("2", resolved))
+	require.Error(t, arg.Validate("3", resolved))
+}
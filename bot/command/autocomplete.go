@@ -0,0 +1,164 @@
+package command
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+// AutoCompleteHandler returns the choices to suggest for the focused option. options contains the other options of
+// the command that the user has already filled in, so that suggestions can depend on them.
+type AutoCompleteHandler func(
+	data interaction.ApplicationCommandAutoCompleteInteraction,
+	focused AutoCompleteValue,
+	options AutoCompleteOptions,
+) []interaction.ApplicationCommandOptionChoice
+
+const maxAutoCompleteChoices = 25
+
+// AutoCompleteValue is the value of an option in an autocomplete interaction, with the type of the argument that it
+// was given for. The focused option may hold partial input, such as "-" for an integer option, so its value may not
+// be of the argument's type.
+type AutoCompleteValue struct {
+	Type interaction.ApplicationCommandOptionType
+	Raw  any
+}
+
+// AutoCompleteOptions maps argument names to the values that the user has filled in
+type AutoCompleteOptions map[string]AutoCompleteValue
+
+func NewAutoCompleteValue(argument Argument, raw any) AutoCompleteValue {
+	return AutoCompleteValue{
+		Type: argument.Type,
+		Raw:  raw,
+	}
+}
+
+// String returns the value as the user typed it. Numbers are formatted without an exponent, so that large integers
+// are not formatted as floats.
+func (v AutoCompleteValue) String() string {
+	switch raw := v.Raw.(type) {
+	case nil:
+		return ""
+	case string:
+		return raw
+	case float64:
+		return strconv.FormatFloat(raw, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(raw)
+	default:
+		return fmt.Sprint(raw)
+	}
+}
+
+// Int returns the value as an integer, if it is a whole number or a string containing one
+func (v AutoCompleteValue) Int() (int, bool) {
+	switch raw := v.Raw.(type) {
+	case float64:
+		if raw != math.Trunc(raw) {
+			return 0, false
+		}
+
+		return int(raw), true
+	case string:
+		i, err := strconv.Atoi(strings.TrimSpace(raw))
+		return i, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// Float returns the value as a number, if it is one or a string containing one
+func (v AutoCompleteValue) Float() (float64, bool) {
+	switch raw := v.Raw.(type) {
+	case float64:
+		return raw, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func (v AutoCompleteValue) Bool() (bool, bool) {
+	b, ok := v.Raw.(bool)
+	return b, ok
+}
+
+// Snowflake returns the ID of the user, channel, role or mentionable that the value refers to
+func (v AutoCompleteValue) Snowflake() (uint64, bool) {
+	raw, ok := v.Raw.(string)
+	if !ok {
+		return 0, false
+	}
+
+	id, err := strconv.ParseUint(raw, 10, 64)
+	return id, err == nil
+}
+
+// Get returns the value of the option with the given name, if the user has filled it in
+func (o AutoCompleteOptions) Get(name string) (AutoCompleteValue, bool) {
+	value, ok := o[name]
+	return value, ok
+}
+
+// FilterChoices removes choices whose values are not of the argument's type, as Discord rejects the whole response if
+// any choice is invalid, and limits the choices to the maximum that Discord accepts
+func (a Argument) FilterChoices(choices []interaction.ApplicationCommandOptionChoice) []interaction.ApplicationCommandOptionChoice {
+	filtered := make([]interaction.ApplicationCommandOptionChoice, 0, min(len(choices), maxAutoCompleteChoices))
+	for _, choice := range choices {
+		if len(filtered) == maxAutoCompleteChoices {
+			break
+		}
+
+		if a.acceptsChoice(choice.Value) {
+			filtered = append(filtered, choice)
+		}
+	}
+
+	return filtered
+}
+
+func (a Argument) acceptsChoice(value any) bool {
+	switch a.Type {
+	case interaction.OptionTypeString:
+		_, ok := value.(string)
+		return ok
+	case interaction.OptionTypeInteger:
+		number, ok := toFloat(value)
+		return ok && number == math.Trunc(number)
+	case interaction.OptionTypeNumber:
+		_, ok := toFloat(value)
+		return ok
+	default:
+		// Discord only supports autocomplete for strings and numbers
+		return false
+	}
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case uint:
+		return float64(v), true
+	case uint32:
+		return float64(v), true
+	case uint64:
+		return float64(v), true
+	case float32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
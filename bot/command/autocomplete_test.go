@@ -0,0 +1,46 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutoCompleteValueFormatsIntegers(t *testing.T) {
+	arg := NewRequiredArgument("panel", "", interaction.OptionTypeInteger, "")
+
+	value := NewAutoCompleteValue(arg, float64(1234567))
+	require.Equal(t, "1234567", value.String())
+
+	i, ok := value.Int()
+	require.True(t, ok)
+	require.Equal(t, 1234567, i)
+
+	// Partial input is sent as typed
+	_, ok = NewAutoCompleteValue(arg, "-").Int()
+	require.False(t, ok)
+}
+
+func TestFilterChoicesByArgumentType(t *testing.T) {
+	arg := NewRequiredArgument("panel", "", interaction.OptionTypeInteger, "")
+
+	choices := arg.FilterChoices([]interaction.ApplicationCommandOptionChoice{
+		{Name: "Support", Value: 1},
+		{Name: "Sales", Value: "2"},
+		{Name: "Billing", Value: 2.5},
+		{Name: "Appeals", Value: uint64(3)},
+	})
+
+	require.Equal(t, []interaction.ApplicationCommandOptionChoice{
+		{Name: "Support", Value: 1},
+		{Name: "Appeals", Value: uint64(3)},
+	}, choices)
+
+	many := make([]interaction.ApplicationCommandOptionChoice, 30)
+	for i := range many {
+		many[i] = interaction.ApplicationCommandOptionChoice{Name: "Panel", Value: i}
+	}
+
+	require.Len(t, arg.FilterChoices(many), 25)
+}
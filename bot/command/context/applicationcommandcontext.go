@@ -7,6 +7,7 @@ import (
 
 	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/errorcontext"
@@ -31,6 +32,8 @@ type SlashCommandContext struct {
 
 	hasReplied *atomic.Bool
 	responseCh chan interaction.ApplicationCommandCallbackData
+	modalCh    chan button.ResponseModal
+	deferred   <-chan struct{}
 }
 
 var _ registry.CommandContext = (*SlashCommandContext)(nil)
@@ -40,6 +43,8 @@ func NewSlashCommandContext(
 	worker *worker.Context,
 	interaction interaction.ApplicationCommandInteraction,
 	responseCh chan interaction.ApplicationCommandCallbackData,
+	modalCh chan button.ResponseModal,
+	deferred <-chan struct{},
 ) SlashCommandContext {
 	c := SlashCommandContext{
 		Context: ctx,
@@ -53,6 +58,8 @@ func NewSlashCommandContext(
 
 		hasReplied: atomic.NewBool(false),
 		responseCh: responseCh,
+		modalCh:    modalCh,
+		deferred:   deferred,
 	}
 
 	c.Replyable = NewReplyable(&c)
@@ -119,6 +126,39 @@ func (c *SlashCommandContext) ReplyWith(response command.MessageResponse) (messa
 	return message.Message{}, nil
 }
 
+// Modal responds to the interaction with a modal. A modal must be the first response to an interaction, so it may
+// only be used by commands with the ModalResponse property, before the interaction is deferred.
+func (c *SlashCommandContext) Modal(res button.ResponseModal) {
+	if c.modalCh == nil {
+		c.HandleError(errors.New("command is not permitted to respond with a modal"))
+		return
+	}
+
+	select {
+	case c.modalCh <- res:
+		c.hasReplied.Store(true)
+	case <-c.deferred:
+		c.HandleError(errors.New("command took too long to respond with a modal"))
+	}
+}
+
+// ActingAs returns a context for the interaction as if it had been run by the member, so that logic that acts on the
+// user running a command can be applied to another member. Replies are still sent in response to the interaction.
+func (c *SlashCommandContext) ActingAs(target member.Member) *SlashCommandContext {
+	data := c.Interaction
+	data.Member = &target
+
+	// The reply counter and response channels are shared by pointer, so replies from either context count towards
+	// the same limit. The embedded helpers must wrap the new context, so that they see the target member.
+	acting := *c
+	acting.Interaction = data
+	acting.InteractionExtension = NewInteractionExtension(data)
+	acting.Replyable = NewReplyable(&acting)
+	acting.StateCache = NewStateCache(&acting)
+
+	return &acting
+}
+
 func (c *SlashCommandContext) Channel() (channel.PartialChannel, error) {
 	return c.Interaction.Channel, nil
 }
@@ -0,0 +1,42 @@
+package command
+
+import (
+	"fmt"
+	"time"
+)
+
+type CooldownScope uint8
+
+const (
+	CooldownScopeUser CooldownScope = iota
+	CooldownScopeChannel
+	CooldownScopeGuild
+)
+
+// Cooldown limits how often a command or component can be used. Up to Uses uses are allowed at once, which are
+// replenished evenly over Interval.
+type Cooldown struct {
+	Scope    CooldownScope
+	Uses     int
+	Interval time.Duration
+}
+
+func NewCooldown(scope CooldownScope, uses int, interval time.Duration) *Cooldown {
+	return &Cooldown{
+		Scope:    scope,
+		Uses:     uses,
+		Interval: interval,
+	}
+}
+
+// Key returns the Redis key of the bucket that the usage counts against
+func (c *Cooldown) Key(name string, guildId, channelId, userId uint64) string {
+	switch c.Scope {
+	case CooldownScopeChannel:
+		return fmt.Sprintf("tickets:cooldown:%s:channel:%d", name, channelId)
+	case CooldownScopeGuild:
+		return fmt.Sprintf("tickets:cooldown:%s:guild:%d", name, guildId)
+	default:
+		return fmt.Sprintf("tickets:cooldown:%s:user:%d:%d", name, guildId, userId)
+	}
+}
@@ -1,15 +1,17 @@
 package general
 
 import (
+	"fmt"
 	"sort"
 	"strings"
 	"time"
 
-	"github.com/elliotchance/orderedmap"
 	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/commandpermission"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel/embed"
@@ -20,14 +22,17 @@ type HelpCommand struct {
 	Registry registry.Registry
 }
 
-func (HelpCommand) Properties() registry.Properties {
+func (c HelpCommand) Properties() registry.Properties {
 	return registry.Properties{
-		Name:             "help",
-		Description:      i18n.HelpHelp,
-		Type:             interaction.ApplicationCommandTypeChatInput,
-		Aliases:          []string{"h"},
-		PermissionLevel:  permission.Everyone,
-		Category:         command.General,
+		Name:            "help",
+		Description:     i18n.HelpHelp,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		Aliases:         []string{"h"},
+		PermissionLevel: permission.Everyone,
+		Category:        command.General,
+		Arguments: command.Arguments(
+			command.NewOptionalAutocompleteableArgument("command", i18n.ArgumentHelpCommand, interaction.OptionTypeString, i18n.MessageHelpUnknownCommand, c.AutoCompleteHandler),
+		),
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 5,
 	}
@@ -37,89 +42,121 @@ func (c HelpCommand) GetExecutor() interface{} {
 	return c.Execute
 }
 
-func (c HelpCommand) Execute(ctx registry.CommandContext) {
-	commandCategories := orderedmap.NewOrderedMap()
-
-	// initialise map with the correct order of categories
-	for _, category := range command.Categories {
-		commandCategories.Set(category, nil)
+func (c HelpCommand) Execute(ctx registry.CommandContext, commandName *string) {
+	if commandName != nil {
+		c.executeDetail(ctx, *commandName)
+		return
 	}
 
-	permLevel, err := ctx.UserPermissionLevel(ctx)
+	msgEmbed, components, err := logic.BuildHelpPage(ctx, ctx, c.Registry, 0)
 	if err != nil {
 		ctx.HandleError(err)
 		return
 	}
 
-	commandIds, err := command.LoadCommandIds(ctx.Worker(), ctx.Worker().BotId)
+	// Explicitly ignore error to fix 403 (Cannot send messages to this user)
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponseWithComponents(msgEmbed, components))
+}
+
+func (c HelpCommand) executeDetail(ctx registry.CommandContext, commandName string) {
+	path := strings.Fields(strings.ToLower(strings.TrimPrefix(strings.TrimSpace(commandName), "/")))
+
+	cmd, ok := c.Registry.Find(path)
+	if !ok || !logic.ShownInHelp(cmd.Properties(), ctx.UserId()) {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageHelpUnknownCommand, commandName)
+		return
+	}
+
+	properties := cmd.Properties()
+
+	overrides, err := commandpermission.Get(ctx, ctx.GuildId())
 	if err != nil {
 		ctx.HandleError(err)
 		return
 	}
 
-	for _, cmd := range c.Registry {
-		properties := cmd.Properties()
+	description := properties.LongDescription
+	if description == "" {
+		description = properties.Description
+	}
 
-		// check bot admin / helper only commands
-		if (properties.AdminOnly && !utils.IsBotAdmin(ctx.UserId())) || (properties.HelperOnly && !utils.IsBotHelper(ctx.UserId())) {
-			continue
-		}
+	msgEmbed := embed.NewEmbed().
+		SetColor(ctx.GetColour(customisation.Green)).
+		SetTitle("/"+strings.Join(path, " ")).
+		SetDescription(ctx.GetMessage(description)).
+		SetFooter("Tickets by jaDevelopment", "https://avatars.githubusercontent.com/u/142818403")
+
+	if len(properties.Children) > 0 {
+		lines := make([]string, 0, len(properties.Children))
+		for _, child := range properties.Children {
+			if !logic.ShownInHelp(child.Properties(), ctx.UserId()) {
+				continue
+			}
 
-		// Show slash commands only
-		if properties.Type != interaction.ApplicationCommandTypeChatInput {
-			continue
+			lines = append(lines, fmt.Sprintf("**%s**: %s", child.Properties().Name, ctx.GetMessage(child.Properties().Description)))
 		}
 
-		if permLevel >= cmd.Properties().PermissionLevel { // only send commands the user has permissions for
-			var current []registry.Command
-			if commands, ok := commandCategories.Get(properties.Category); ok {
-				if commands == nil {
-					current = make([]registry.Command, 0)
-				} else {
-					current = commands.([]registry.Command)
-				}
+		sort.Strings(lines)
+		msgEmbed.AddField(ctx.GetMessage(i18n.MessageHelpSubcommands), utils.StringMax(strings.Join(lines, "\n"), 1024), false)
+	}
+
+	if len(properties.Arguments) > 0 {
+		lines := make([]string, len(properties.Arguments))
+		for i, arg := range properties.Arguments {
+			// Use the same notation as the command list
+			name := fmt.Sprintf("<%s>", arg.Name)
+			if arg.Required {
+				name = fmt.Sprintf("[%s]", arg.Name)
 			}
-			current = append(current, cmd)
 
-			commandCategories.Set(properties.Category, current)
+			lines[i] = fmt.Sprintf("`%s`: %s", name, ctx.GetMessage(arg.Description))
 		}
+
+		msgEmbed.AddField(ctx.GetMessage(i18n.MessageHelpArguments), utils.StringMax(strings.Join(lines, "\n"), 1024), false)
 	}
 
-	embed := embed.NewEmbed().
-		SetColor(ctx.GetColour(customisation.Green)).
-		SetTitle(ctx.GetMessage(i18n.TitleHelp))
-
-	for _, category := range commandCategories.Keys() {
-		var commands []registry.Command
-		if retrieved, ok := commandCategories.Get(category.(command.Category)); ok {
-			if retrieved == nil {
-				commands = make([]registry.Command, 0)
-			} else {
-				commands = retrieved.([]registry.Command)
-			}
-		}
+	requirement := overrides.Requirement(path, properties.PermissionLevel)
+	msgEmbed.AddField(ctx.GetMessage(i18n.MessageHelpPermission), formatRequirement(ctx, requirement), true)
 
-		sort.Slice(commands, func(i, j int) bool {
-			return commands[i].Properties().Name < commands[j].Properties().Name
-		})
+	if properties.Cooldown != nil {
+		msgEmbed.AddField(ctx.GetMessage(i18n.MessageHelpCooldown), formatCooldown(ctx, properties.Cooldown), true)
+	}
 
-		if len(commands) > 0 {
-			formatted := make([]string, 0)
-			for _, cmd := range commands {
-				var commandId *uint64
-				if tmp, ok := commandIds[cmd.Properties().Name]; ok {
-					commandId = &tmp
-				}
+	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(msgEmbed))
+}
 
-				formatted = append(formatted, registry.FormatHelp(cmd, ctx.GuildId(), commandId))
-			}
+func (c HelpCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, _ command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
+	return logic.CommandPathChoices(c.Registry, data, focused.String())
+}
 
-			embed.AddField(string(category.(command.Category)), strings.Join(formatted, "\n"), false)
-		}
+func formatRequirement(ctx registry.CommandContext, requirement commandpermission.Requirement) string {
+	var level string
+	switch requirement.Level {
+	case permission.Support:
+		level = ctx.GetMessage(i18n.MessageHelpSupportOnly)
+	case permission.Admin:
+		level = ctx.GetMessage(i18n.MessageHelpAdminOnly)
+	default:
+		level = ctx.GetMessage(i18n.MessageHelpEveryone)
 	}
 
-	embed.SetFooter("Tickets by jaDevelopment", "https://avatars.githubusercontent.com/u/142818403")
+	lines := []string{level}
+	for _, roleId := range requirement.RoleIds {
+		lines = append(lines, fmt.Sprintf("<@&%d>", roleId))
+	}
 
-	// Explicitly ignore error to fix 403 (Cannot send messages to this user)
-	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(embed))
+	return utils.StringMax(strings.Join(lines, "\n"), 1024)
+}
+
+func formatCooldown(ctx registry.CommandContext, cooldown *command.Cooldown) string {
+	seconds := int(cooldown.Interval.Seconds())
+
+	switch cooldown.Scope {
+	case command.CooldownScopeChannel:
+		return ctx.GetMessage(i18n.MessageHelpCooldownChannel, cooldown.Uses, seconds)
+	case command.CooldownScopeGuild:
+		return ctx.GetMessage(i18n.MessageHelpCooldownGuild, cooldown.Uses, seconds)
+	default:
+		return ctx.GetMessage(i18n.MessageHelpCooldownUser, cooldown.Uses, seconds)
+	}
 }
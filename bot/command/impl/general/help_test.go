@@ -0,0 +1,44 @@
+package general
+
+import (
+	"testing"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/tags"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/tickets"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHelpAutoCompleteIncludesSubcommands(t *testing.T) {
+	help := HelpCommand{
+		Registry: registry.Registry{
+			"managetags":   tags.ManageTagsCommand{},
+			"rename":       tickets.RenameCommand{},
+			"Start Ticket": tickets.StartTicketCommand{},
+		},
+	}
+
+	var names []string
+	for _, choice := range help.AutoCompleteHandler(interaction.ApplicationCommandAutoCompleteInteraction{}, command.AutoCompleteValue{Raw: "/tags del"}, nil) {
+		names = append(names, choice.Name)
+	}
+
+	require.Equal(t, []string{"managetags delete"}, names)
+
+	names = nil
+	for _, choice := range help.AutoCompleteHandler(interaction.ApplicationCommandAutoCompleteInteraction{}, command.AutoCompleteValue{}, nil) {
+		names = append(names, choice.Name)
+	}
+
+	// Context menu commands have no usage to show
+	require.Equal(t, []string{"managetags", "managetags add", "managetags delete", "managetags list", "rename"}, names)
+
+	cmd, ok := help.findCommand([]string{"managetags", "add"})
+	require.True(t, ok)
+	require.Equal(t, "add", cmd.Properties().Name)
+
+	_, ok = help.findCommand([]string{"managetags", "rename"})
+	require.False(t, ok)
+}
@@ -0,0 +1,55 @@
+package setup
+
+import (
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type AutoAssignSetupCommand struct{}
+
+func (AutoAssignSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "auto-assign",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("mode", i18n.ArgumentSetupAutoAssignMode, interaction.OptionTypeString, i18n.SetupAutoAssignInvalidMode).
+				WithChoices(
+					interaction.ApplicationCommandOptionChoice{Name: "Disabled", Value: string(database.AutoAssignModeDisabled)},
+					interaction.ApplicationCommandOptionChoice{Name: "Round robin", Value: string(database.AutoAssignModeRoundRobin)},
+					interaction.ApplicationCommandOptionChoice{Name: "Least open claims", Value: string(database.AutoAssignModeLeastClaims)},
+					interaction.ApplicationCommandOptionChoice{Name: "Random", Value: string(database.AutoAssignModeRandom)},
+				),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 3,
+	}
+}
+
+func (c AutoAssignSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute sets how new tickets are assigned to the on-call members of the panel's support teams
+func (AutoAssignSetupCommand) Execute(ctx registry.CommandContext, mode string) {
+	if err := dbclient.Client.AutoAssignSettings.SetMode(ctx, ctx.GuildId(), database.AutoAssignMode(mode)); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if database.AutoAssignMode(mode) == database.AutoAssignModeDisabled {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupAutoAssignDisabled)
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupAutoAssignComplete)
+	}
+}
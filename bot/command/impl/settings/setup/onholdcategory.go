@@ -0,0 +1,56 @@
+package setup
+
+import (
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type OnHoldCategorySetupCommand struct{}
+
+func (OnHoldCategorySetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "on-hold-category",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("category", i18n.ArgumentSetupOnHoldCategory, interaction.OptionTypeChannel, i18n.SetupOnHoldCategoryType).
+				WithChannelTypes(channel.ChannelTypeGuildCategory),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c OnHoldCategorySetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute sets the category that tickets on hold are moved to. Tickets on hold are left in place if no category is
+// given.
+func (OnHoldCategorySetupCommand) Execute(ctx registry.CommandContext, categoryId *uint64) {
+	settings := database.HoldSettings{
+		OnHoldCategoryId: categoryId,
+	}
+
+	if err := dbclient.Client.HoldSettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if categoryId == nil {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupOnHoldCategoryRemoved)
+	} else {
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupOnHoldCategoryComplete, *categoryId)
+	}
+}
@@ -0,0 +1,84 @@
+package setup
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type PanelPrioritySetupCommand struct{}
+
+func (c PanelPrioritySetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "panel-priority",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", i18n.ArgumentSetupPanelPriorityPanel, interaction.OptionTypeInteger, i18n.SetupPanelInvalid, panelAutoCompleteHandler),
+			command.NewRequiredArgument("priority", i18n.ArgumentSetupPanelPriorityPriority, interaction.OptionTypeString, i18n.MessagePriorityInvalid).
+				WithChoices(logic.PriorityChoices()...),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c PanelPrioritySetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute sets the priority that tickets opened from the panel are given
+func (PanelPrioritySetupCommand) Execute(ctx registry.CommandContext, panelId int, priorityName string) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify panel is from same guild
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPanelInvalid)
+		return
+	}
+
+	priority, ok := logic.ParsePriority(priorityName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessagePriorityInvalid)
+		return
+	}
+
+	if err := dbclient.Client.PanelPriorities.Set(ctx, panel.PanelId, priority); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPanelPriorityComplete, panel.Title, ctx.GetMessage(logic.PriorityName(priority)))
+}
+
+func panelAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, _ command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	choices, err := logic.PanelChoices(ctx, data.GuildId.Value, focused.String())
+	if err != nil {
+		fmt.Print(err)
+		return nil
+	}
+
+	return choices
+}
@@ -0,0 +1,94 @@
+package setup
+
+import (
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+// maxSlaTargetMinutes is one week
+const maxSlaTargetMinutes = 60 * 24 * 7
+
+type PanelSlaSetupCommand struct{}
+
+func (c PanelSlaSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "panel-sla",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("panel", i18n.ArgumentSetupPanelSlaPanel, interaction.OptionTypeInteger, i18n.SetupPanelInvalid, panelAutoCompleteHandler),
+			command.NewOptionalArgument("first_response", i18n.ArgumentSetupPanelSlaFirstResponse, interaction.OptionTypeInteger, i18n.SetupPanelSlaInvalidTarget).
+				WithMinValue(1).
+				WithMaxValue(maxSlaTargetMinutes),
+			command.NewOptionalArgument("next_response", i18n.ArgumentSetupPanelSlaNextResponse, interaction.OptionTypeInteger, i18n.SetupPanelSlaInvalidTarget).
+				WithMinValue(1).
+				WithMaxValue(maxSlaTargetMinutes),
+			command.NewOptionalArgument("escalation_role", i18n.ArgumentSetupPanelSlaEscalationRole, interaction.OptionTypeRole, "infallible"),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c PanelSlaSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute replaces the response time targets, in minutes, of tickets opened from the panel. If no targets are given,
+// tickets opened from the panel are no longer timed.
+func (PanelSlaSetupCommand) Execute(ctx registry.CommandContext, panelId int, firstResponse, nextResponse *int, escalationRoleId *uint64) {
+	panel, err := dbclient.Client.Panel.GetById(ctx, panelId)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Verify panel is from same guild
+	if panel.PanelId == 0 || panel.GuildId != ctx.GuildId() {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPanelInvalid)
+		return
+	}
+
+	if firstResponse == nil && nextResponse == nil {
+		if err := dbclient.Client.PanelSlas.Delete(ctx, panel.PanelId); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPanelSlaRemoved, panel.Title)
+		return
+	}
+
+	sla := database.PanelSla{
+		PanelId:          panel.PanelId,
+		FirstResponse:    minutesToDuration(firstResponse),
+		NextResponse:     minutesToDuration(nextResponse),
+		EscalationRoleId: escalationRoleId,
+	}
+
+	if err := dbclient.Client.PanelSlas.Set(ctx, sla); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPanelSlaComplete, panel.Title)
+}
+
+func minutesToDuration(minutes *int) *time.Duration {
+	if minutes == nil {
+		return nil
+	}
+
+	duration := time.Duration(*minutes) * time.Minute
+	return &duration
+}
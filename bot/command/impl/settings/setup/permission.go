@@ -0,0 +1,102 @@
+package setup
+
+import (
+	"strings"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type PermissionSetupCommand struct {
+	Registry registry.Registry
+}
+
+func (c PermissionSetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "permission",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("command", i18n.ArgumentSetupPermissionCommand, interaction.OptionTypeString, i18n.SetupPermissionUnknownCommand, c.AutoCompleteHandler),
+			command.NewOptionalArgument("level", i18n.ArgumentSetupPermissionLevel, interaction.OptionTypeInteger, i18n.SetupPermissionInvalidLevel).
+				WithChoices(
+					interaction.ApplicationCommandOptionChoice{Name: "Everyone", Value: int(permission.Everyone)},
+					interaction.ApplicationCommandOptionChoice{Name: "Support", Value: int(permission.Support)},
+					interaction.ApplicationCommandOptionChoice{Name: "Admin", Value: int(permission.Admin)},
+				),
+			command.NewOptionalArgument("role", i18n.ArgumentSetupPermissionRole, interaction.OptionTypeRole, "infallible"),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c PermissionSetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute overrides the permission level required to run the command, and adds a role that may run it regardless of
+// permission level. If neither is given, the override is removed, so that the command's default applies again.
+func (c PermissionSetupCommand) Execute(ctx registry.CommandContext, commandName string, level *int, roleId *uint64) {
+	path := strings.Fields(strings.ToLower(strings.TrimPrefix(strings.TrimSpace(commandName), "/")))
+	if _, ok := c.Registry.Find(path); !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.SetupPermissionUnknownCommand, commandName)
+		return
+	}
+
+	name := strings.Join(path, " ")
+
+	if level == nil && roleId == nil {
+		if err := dbclient.Client.CommandPermissionOverrides.Delete(ctx, ctx.GuildId(), name); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPermissionReset, name)
+		return
+	}
+
+	overrides, err := dbclient.Client.CommandPermissionOverrides.GetAll(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	override, ok := overrides[name]
+	if !ok {
+		override = database.CommandPermissionOverride{
+			GuildId: ctx.GuildId(),
+			Command: name,
+		}
+	}
+
+	if level != nil {
+		override.PermissionLevel = level
+	}
+
+	if roleId != nil && !utils.Contains(override.RoleIds, *roleId) {
+		override.RoleIds = append(override.RoleIds, *roleId)
+	}
+
+	if err := dbclient.Client.CommandPermissionOverrides.Set(ctx, override); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPermissionComplete, name)
+}
+
+func (c PermissionSetupCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, _ command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
+	return logic.CommandPathChoices(c.Registry, data, focused.String())
+}
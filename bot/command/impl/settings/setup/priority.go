@@ -0,0 +1,55 @@
+package setup
+
+import (
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type PrioritySetupCommand struct{}
+
+func (PrioritySetupCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "priority",
+		Description:     i18n.HelpSetup,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Admin,
+		Category:        command.Settings,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("channel_prefix", i18n.ArgumentSetupPriorityChannelPrefix, interaction.OptionTypeBoolean, "infallible"),
+			command.NewOptionalArgument("high_priority_category", i18n.ArgumentSetupPriorityCategory, interaction.OptionTypeChannel, i18n.SetupPriorityCategoryType).
+				WithChannelTypes(channel.ChannelTypeGuildCategory),
+			command.NewOptionalArgument("urgent_ping_role", i18n.ArgumentSetupPriorityUrgentPingRole, interaction.OptionTypeRole, "infallible"),
+		),
+		InteractionOnly: true,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c PrioritySetupCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+// Execute replaces the guild's priority settings. The category and role are removed if they are not given.
+func (PrioritySetupCommand) Execute(ctx registry.CommandContext, channelPrefix bool, categoryId, urgentPingRole *uint64) {
+	settings := database.PrioritySettings{
+		HighPriorityCategoryId: categoryId,
+		UrgentPingRole:         urgentPingRole,
+		ChannelPrefix:          channelPrefix,
+	}
+
+	if err := dbclient.Client.PrioritySettings.Set(ctx, ctx.GuildId(), settings); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitleSetup, i18n.SetupPriorityComplete)
+}
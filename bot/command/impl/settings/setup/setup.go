@@ -9,9 +9,10 @@ import (
 )
 
 type SetupCommand struct {
+	Registry registry.Registry
 }
 
-func (SetupCommand) Properties() registry.Properties {
+func (c SetupCommand) Properties() registry.Properties {
 	return registry.Properties{
 		Name:            "setup",
 		Description:     i18n.HelpSetup,
@@ -23,6 +24,12 @@ func (SetupCommand) Properties() registry.Properties {
 			LimitSetupCommand{},
 			TranscriptsSetupCommand{},
 			ThreadsSetupCommand{},
+			PermissionSetupCommand{Registry: c.Registry},
+			PrioritySetupCommand{},
+			PanelPrioritySetupCommand{},
+			PanelSlaSetupCommand{},
+			AutoAssignSetupCommand{},
+			OnHoldCategorySetupCommand{},
 		},
 	}
 }
@@ -25,7 +25,7 @@ func (TranscriptsSetupCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Admin,
 		Category:        command.Settings,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("channel", "The channel that ticket transcripts should be sent to", interaction.OptionTypeChannel, i18n.SetupTranscriptsInvalid),
+			command.NewRequiredArgument("channel", i18n.ArgumentSetupTranscriptsChannel, interaction.OptionTypeChannel, i18n.SetupTranscriptsInvalid),
 		),
 		Timeout: time.Second * 5,
 	}
@@ -32,6 +32,7 @@ func (StatsServerCommand) Properties() registry.Properties {
 		Category:         command.Statistics,
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 10,
+		Cooldown:         command.NewCooldown(command.CooldownScopeGuild, 2, time.Minute),
 	}
 }
 
@@ -88,6 +89,23 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 		return
 	})
 
+	// SLA breaches
+	var slaBreaches, slaBreachesMonthly, slaBreachesWeekly int
+	group.Go(func() (err error) {
+		slaBreaches, err = dbclient.Client.SlaBreaches.GetCount(ctx, ctx.GuildId())
+		return
+	})
+
+	group.Go(func() (err error) {
+		slaBreachesMonthly, err = dbclient.Client.SlaBreaches.GetCountInterval(ctx, ctx.GuildId(), time.Hour*24*28)
+		return
+	})
+
+	group.Go(func() (err error) {
+		slaBreachesWeekly, err = dbclient.Client.SlaBreaches.GetCountInterval(ctx, ctx.GuildId(), time.Hour*24*7)
+		return
+	})
+
 	// tickets per day
 	var ticketVolumeTable string
 	group.Go(func() error {
@@ -129,6 +147,9 @@ func (StatsServerCommand) Execute(ctx registry.CommandContext) {
 		AddField("Average Ticket Duration (Total)", formatNullableTime(ticketDuration.AllTime), true).
 		AddField("Average Ticket Duration (Monthly)", formatNullableTime(ticketDuration.Monthly), true).
 		AddField("Average Ticket Duration (Weekly)", formatNullableTime(ticketDuration.Weekly), true).
+		AddField("SLA Breaches (Total)", strconv.Itoa(slaBreaches), true).
+		AddField("SLA Breaches (Monthly)", strconv.Itoa(slaBreachesMonthly), true).
+		AddField("SLA Breaches (Weekly)", strconv.Itoa(slaBreachesWeekly), true).
 		AddField("Ticket Volume", fmt.Sprintf("```\n%s\n```", ticketVolumeTable), false)
 
 	_, _ = ctx.ReplyWith(command.NewEphemeralEmbedMessageResponse(msgEmbed))
@@ -29,10 +29,11 @@ func (StatsUserCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Support,
 		Category:        command.Statistics,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("user", "User whose statistics to retrieve", interaction.OptionTypeUser, i18n.MessageInvalidUser),
+			command.NewRequiredArgument("user", i18n.ArgumentStatsUserUser, interaction.OptionTypeUser, i18n.MessageInvalidUser),
 		),
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 30,
+		Cooldown:         command.NewCooldown(command.CooldownScopeUser, 3, time.Minute),
 	}
 }
 
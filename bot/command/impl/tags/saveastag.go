@@ -0,0 +1,86 @@
+package tags
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/interaction/component"
+)
+
+const (
+	SaveAsTagModalId   = "save_as_tag_submit"
+	SaveAsTagMaxLength = 4000
+)
+
+type SaveAsTagCommand struct {
+}
+
+func (SaveAsTagCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "Save As Tag",
+		Type:             interaction.ApplicationCommandTypeMessage,
+		PermissionLevel:  permission.Support,
+		Category:         command.Tags,
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+		ModalResponse:    true,
+		Timeout:          time.Second * 3,
+	}
+}
+
+func (c SaveAsTagCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (SaveAsTagCommand) Execute(ctx registry.CommandContext) {
+	cmd, ok := ctx.(*context.SlashCommandContext)
+	if !ok {
+		return
+	}
+
+	msg, ok := cmd.ResolvedMessage(cmd.Interaction.Data.TargetId)
+	if !ok {
+		ctx.HandleError(errors.New("Message missing from resolved data"))
+		return
+	}
+
+	if len(strings.TrimSpace(msg.Content)) == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageSaveAsTagEmpty)
+		return
+	}
+
+	cmd.Modal(button.ResponseModal{
+		Data: interaction.ModalResponseData{
+			CustomId: SaveAsTagModalId,
+			Title:    ctx.GetMessage(i18n.MessageTag),
+			Components: []component.Component{
+				component.BuildActionRow(component.BuildInputText(component.InputText{
+					Style:     component.TextStyleShort,
+					CustomId:  "id",
+					Label:     ctx.GetMessage(i18n.MessageSaveAsTagIdLabel),
+					Required:  utils.Ptr(true),
+					MinLength: utils.Ptr(uint32(1)),
+					MaxLength: utils.Ptr(uint32(16)),
+				})),
+				component.BuildActionRow(component.BuildInputText(component.InputText{
+					Style:     component.TextStyleParagraph,
+					CustomId:  "content",
+					Label:     ctx.GetMessage(i18n.MessageSaveAsTagContentLabel),
+					Value:     utils.Ptr(utils.StringMax(msg.Content, SaveAsTagMaxLength)),
+					Required:  utils.Ptr(true),
+					MaxLength: utils.Ptr(uint32(SaveAsTagMaxLength)),
+				})),
+			},
+		},
+	})
+}
@@ -31,9 +31,10 @@ func (c TagCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Everyone,
 		Category:        command.Tags,
 		Arguments: command.Arguments(
-			command.NewRequiredAutocompleteableArgument("id", "The ID of the tag to be sent to the channel", interaction.OptionTypeString, i18n.MessageTagInvalidArguments, c.AutoCompleteHandler),
+			command.NewRequiredAutocompleteableArgument("id", i18n.ArgumentTagId, interaction.OptionTypeString, i18n.MessageTagInvalidArguments, c.AutoCompleteHandler),
 		),
-		Timeout: time.Second * 5,
+		Timeout:  time.Second * 5,
+		Cooldown: command.NewCooldown(command.CooldownScopeChannel, 5, time.Second*30),
 	}
 }
 
@@ -101,23 +102,29 @@ func (TagCommand) Execute(ctx registry.CommandContext, tagId string) {
 			fmt.Print(err, ctx.ToErrorContext())
 		}
 
-		if err := dbclient.Client.Tickets.SetStatus(ctx, ctx.GuildId(), ticket.Id, model.TicketStatusPending); err != nil {
+		// Tickets on hold keep their status until they are resumed
+		onHold, err := logic.IsOnHold(ctx, ticket)
+		if err != nil {
 			fmt.Print(err, ctx.ToErrorContext())
-		}
-
-		if !ticket.IsThread {
-			if err := dbclient.Client.CategoryUpdateQueue.Add(ctx, ctx.GuildId(), ticket.Id, model.TicketStatusPending); err != nil {
+		} else if !onHold {
+			if err := dbclient.Client.Tickets.SetStatus(ctx, ctx.GuildId(), ticket.Id, model.TicketStatusPending); err != nil {
 				fmt.Print(err, ctx.ToErrorContext())
 			}
+
+			if !ticket.IsThread {
+				if err := dbclient.Client.CategoryUpdateQueue.Add(ctx, ctx.GuildId(), ticket.Id, model.TicketStatusPending); err != nil {
+					fmt.Print(err, ctx.ToErrorContext())
+				}
+			}
 		}
 	}
 }
 
-func (TagCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+func (TagCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, _ command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
 	defer cancel()
 
-	tagIds, err := dbclient.Client.Tag.GetStartingWith(ctx, data.GuildId.Value, value, 25)
+	tagIds, err := dbclient.Client.Tag.GetStartingWith(ctx, data.GuildId.Value, focused.String(), 25)
 	if err != nil {
 		fmt.Print(err) // TODO: Error context
 		return nil
@@ -0,0 +1,107 @@
+package tickets
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type AddToNotesCommand struct {
+}
+
+func (AddToNotesCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "Add To Notes",
+		Type:             interaction.ApplicationCommandTypeMessage,
+		PermissionLevel:  permcache.Support,
+		Category:         command.Tickets,
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 7,
+	}
+}
+
+func (c AddToNotesCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (AddToNotesCommand) Execute(ctx registry.CommandContext) {
+	interaction, ok := ctx.(*context.SlashCommandContext)
+	if !ok {
+		return
+	}
+
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Test valid ticket channel
+	if ticket.Id == 0 || ticket.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	if ticket.IsThread {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotesChannelModeOnly)
+		return
+	}
+
+	messageId := interaction.Interaction.Data.TargetId
+
+	msg, ok := interaction.ResolvedMessage(messageId)
+	if !ok {
+		ctx.HandleError(errors.New("Message missing from resolved data"))
+		return
+	}
+
+	var threadId uint64
+	if ticket.NotesThreadId != nil {
+		threadId = *ticket.NotesThreadId
+
+		if err := ctx.Worker().AddThreadMember(threadId, ctx.UserId()); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+	} else {
+		threadId, err = createNotesThread(ctx, ticket)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+	}
+
+	messageLink := fmt.Sprintf("https://discord.com/channels/%d/%d/%d", ctx.GuildId(), ctx.ChannelId(), msg.Id)
+
+	msgEmbed := utils.BuildEmbedRaw(ctx.GetColour(customisation.Green), "", utils.StringMax(msg.Content, 4096), nil).
+		SetAuthor(msg.Author.Username, "", msg.Author.AvatarUrl(256)).
+		SetTimestamp(msg.Timestamp)
+
+	msgEmbed.AddField(ctx.GetMessage(i18n.Ticket), ctx.GetMessage(i18n.MessageNotesAddedFrom, ctx.UserId(), messageLink), false)
+
+	// Only the first image can be displayed in the embed
+	for _, attachment := range msg.Attachments {
+		if attachment.Height > 0 {
+			msgEmbed.SetImage(attachment.Url)
+			break
+		}
+	}
+
+	if _, err := ctx.Worker().CreateMessageEmbed(threadId, msgEmbed); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	ctx.Reply(customisation.Green, i18n.Success, i18n.MessageNotesMessageAdded, threadId)
+}
@@ -27,7 +27,7 @@ func (c CloseCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Everyone,
 		Category:        command.Tickets,
 		Arguments: command.Arguments(
-			command.NewOptionalAutocompleteableArgument("reason", "The reason the ticket was closed", interaction.OptionTypeString, "infallible", c.AutoCompleteHandler), // should never fail
+			command.NewOptionalAutocompleteableArgument("reason", i18n.ArgumentCloseReason, interaction.OptionTypeString, "infallible", c.AutoCompleteHandler), // should never fail
 		),
 		Timeout: constants.TimeoutCloseTicket,
 	}
@@ -41,7 +41,7 @@ func (CloseCommand) Execute(ctx registry.CommandContext, reason *string) {
 	logic.CloseTicket(ctx, ctx, reason, false)
 }
 
-func (CloseCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+func (CloseCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, _ command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
 	var reasons []string
 	var err error
 
@@ -55,6 +55,8 @@ func (CloseCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoC
 	ctx, cancel := utils.ContextTimeout(time.Millisecond * 1500)
 	defer cancel()
 
+	value := focused.String()
+
 	// If there is no text provided by the user yet, and this is a ticket channel, we can use our materialised view to
 	// get the most common close reasons for that panel. Otherwise, perform a dynamic query to get the most common
 	// reasons for that text for all panels.
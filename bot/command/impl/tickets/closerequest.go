@@ -12,6 +12,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel/embed"
@@ -23,19 +24,26 @@ import (
 type CloseRequestCommand struct {
 }
 
+const maxCloseDelayHours = 24 * 30
+
 func (c CloseRequestCommand) Properties() registry.Properties {
 	return registry.Properties{
 		Name:            "closerequest",
 		Description:     i18n.HelpCloseRequest,
+		LongDescription: i18n.HelpLongCloseRequest,
 		Type:            interaction.ApplicationCommandTypeChatInput,
 		PermissionLevel: permission.Support,
 		Category:        command.Tickets,
 		InteractionOnly: true,
 		Arguments: command.Arguments(
-			command.NewOptionalArgument("close_delay", "Hours to close the ticket in if the user does not respond", interaction.OptionTypeInteger, "infallible"),
-			command.NewOptionalAutocompleteableArgument("reason", "The reason the ticket was closed", interaction.OptionTypeString, "infallible", c.ReasonAutoCompleteHandler),
+			command.NewOptionalArgument("close_delay", i18n.ArgumentCloseRequestCloseDelay, interaction.OptionTypeInteger, i18n.MessageCloseRequestInvalidDelay).
+				WithMinValue(1).
+				WithMaxValue(maxCloseDelayHours),
+			command.NewOptionalAutocompleteableArgument("reason", i18n.ArgumentCloseReason, interaction.OptionTypeString, i18n.MessageCloseReasonTooLong, c.ReasonAutoCompleteHandler).
+				WithMaxLength(255),
 		),
-		Timeout: time.Second * 5,
+		Timeout:  time.Second * 5,
+		Cooldown: command.NewCooldown(command.CooldownScopeChannel, 2, time.Minute),
 	}
 }
 
@@ -55,11 +63,6 @@ func (CloseRequestCommand) Execute(ctx registry.CommandContext, closeDelay *int,
 		return
 	}
 
-	if reason != nil && len(*reason) > 255 {
-		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCloseReasonTooLong)
-		return
-	}
-
 	var closeAt *time.Time = nil
 	if closeDelay != nil {
 		tmp := time.Now().Add(time.Hour * time.Duration(*closeDelay))
@@ -120,6 +123,17 @@ func (CloseRequestCommand) Execute(ctx registry.CommandContext, closeDelay *int,
 		return
 	}
 
+	// Tickets on hold keep their status until they are resumed
+	onHold, err := logic.IsOnHold(ctx, ticket)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if onHold {
+		return
+	}
+
 	if err := dbclient.Client.Tickets.SetStatus(ctx, ctx.GuildId(), ticket.Id, model.TicketStatusPending); err != nil {
 		ctx.HandleError(err)
 		return
@@ -134,6 +148,6 @@ func (CloseRequestCommand) Execute(ctx registry.CommandContext, closeDelay *int,
 }
 
 // ReasonAutoCompleteHandler TODO: Make a utility function rather than call the Close handler directly
-func (CloseRequestCommand) ReasonAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
-	return CloseCommand{}.AutoCompleteHandler(data, value)
+func (CloseRequestCommand) ReasonAutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, options command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
+	return CloseCommand{}.AutoCompleteHandler(data, focused, options)
 }
@@ -0,0 +1,88 @@
+package tickets
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type HoldCommand struct {
+}
+
+const maxHoldHours = 24 * 30
+
+func (HoldCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "hold",
+		Description:     i18n.HelpHold,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewOptionalArgument("reason", i18n.ArgumentHoldReason, interaction.OptionTypeString, i18n.MessageHoldReasonTooLong).
+				WithMaxLength(255),
+			command.NewOptionalArgument("hours", i18n.ArgumentHoldHours, interaction.OptionTypeInteger, i18n.MessageHoldInvalidHours).
+				WithMinValue(1).
+				WithMaxValue(maxHoldHours),
+		),
+		Timeout: time.Second * 5,
+	}
+}
+
+func (c HoldCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (HoldCommand) Execute(ctx registry.CommandContext, reason *string, hours *int) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	var until *time.Time
+	if hours != nil {
+		tmp := time.Now().Add(time.Hour * time.Duration(*hours))
+		until = &tmp
+	}
+
+	if err := logic.HoldTicket(ctx, ticket, ctx.UserId(), reason, until); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var messageId i18n.MessageId
+	format := []interface{}{ctx.UserId()}
+	if reason == nil {
+		messageId = i18n.MessageHoldNoReason
+	} else {
+		messageId = i18n.MessageHoldWithReason
+		format = append(format, strings.ReplaceAll(*reason, "`", "\\`"))
+	}
+
+	var fields []embed.EmbedField
+	if until != nil {
+		fields = append(fields, embed.EmbedField{
+			Name:   ctx.GetMessage(i18n.MessageHoldResumes),
+			Value:  fmt.Sprintf("<t:%d:f>", until.Unix()),
+			Inline: false,
+		})
+	}
+
+	ctx.ReplyWithFieldsPermanent(customisation.Orange, i18n.TitleOnHold, messageId, fields, format...)
+}
@@ -0,0 +1,232 @@
+package tickets
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/constants"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type MergeCommand struct {
+}
+
+func (c MergeCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "merge",
+		Description:     i18n.HelpMerge,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredAutocompleteableArgument("ticket", i18n.ArgumentMergeTicket, interaction.OptionTypeInteger, i18n.MessageMergeTicketNotFound, c.AutoCompleteHandler),
+		),
+		DefaultEphemeral: true,
+		Timeout:          constants.TimeoutCloseTicket + time.Second*10,
+	}
+}
+
+func (c MergeCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (MergeCommand) Execute(ctx registry.CommandContext, ticketId int) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Test valid ticket channel
+	if ticket.Id == 0 || ticket.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	if ticketId == ticket.Id {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageMergeSameTicket)
+		return
+	}
+
+	merged, err := dbclient.Client.Tickets.Get(ctx, ticketId, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if merged.Id == 0 || !merged.Open || merged.ChannelId == nil {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageMergeTicketNotFound)
+		return
+	}
+
+	// Record the merge before the merged ticket is closed, so that its close message and transcript can link to this
+	// ticket
+	if err := dbclient.Client.TicketMerges.Create(ctx, ctx.GuildId(), merged.Id, ticket.Id); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// The members of the merged ticket are read before it is closed, but only given access to this ticket once it has
+	// been closed, so that they keep no access if the merge fails
+	mergedMembers, err := dbclient.Client.TicketMembers.Get(ctx, ctx.GuildId(), merged.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Included in the merged ticket's transcript
+	mergedIntoEmbed := utils.BuildEmbed(ctx, customisation.Orange, i18n.TitleMerge, i18n.MessageMergedInto, nil, ticket.Id, *ticket.ChannelId)
+	mergedIntoMessage, err := ctx.Worker().CreateMessageEmbed(*merged.ChannelId, mergedIntoEmbed)
+	if err != nil {
+		ctx.HandleWarning(err)
+	}
+
+	reason := ctx.GetMessage(i18n.MessageMergeCloseReason, ticket.Id)
+	cc := cmdcontext.NewAutoCloseContext(ctx, ctx.Worker(), ctx.GuildId(), *merged.ChannelId, ctx.UserId())
+	logic.CloseTicket(ctx, cc, &reason, true)
+
+	// CloseTicket reports failures to the merged ticket's channel, so check that it was closed before replying
+	merged, err = dbclient.Client.Tickets.Get(ctx, merged.Id, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if merged.Open {
+		// The ticket has not been merged, so it can be merged again once the problem is fixed
+		if err := dbclient.Client.TicketMerges.Delete(ctx, ctx.GuildId(), merged.Id); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		if mergedIntoMessage.Id != 0 {
+			_ = ctx.Worker().DeleteMessage(*merged.ChannelId, mergedIntoMessage.Id)
+		}
+
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageMergeCloseFailed, merged.Id)
+		return
+	}
+
+	// Move the opener and the members of the merged ticket into this ticket
+	existingMembers, err := dbclient.Client.TicketMembers.Get(ctx, ctx.GuildId(), ticket.Id)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	additionalPermissions, err := dbclient.Client.TicketPermissions.Get(ctx, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var added []string
+	for _, userId := range append([]uint64{merged.UserId}, mergedMembers...) {
+		if userId == ticket.UserId || utils.Contains(existingMembers, userId) {
+			continue
+		}
+
+		if err := addMergedMember(ctx, ticket, userId, additionalPermissions); err != nil {
+			// Carry on with the merge, staff can still /add the member by hand
+			ctx.HandleWarning(err)
+			continue
+		}
+
+		existingMembers = append(existingMembers, userId)
+		added = append(added, fmt.Sprintf("<@%d>", userId))
+	}
+
+	settings, err := ctx.Settings()
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var fields []embed.EmbedField
+	if len(added) > 0 {
+		fields = append(fields, embed.EmbedField{
+			Name:   ctx.GetMessage(i18n.MessageMergeMembersAdded),
+			Value:  utils.StringMax(strings.Join(added, " "), 1024),
+			Inline: false,
+		})
+	}
+
+	if settings.StoreTranscripts {
+		fields = append(fields, embed.EmbedField{
+			Name:   ctx.GetMessage(i18n.MessageMergeTranscript),
+			Value:  logic.TranscriptUrl(ctx.GuildId(), merged.Id),
+			Inline: false,
+		})
+	}
+
+	ctx.ReplyWithFieldsPermanent(customisation.Green, i18n.TitleMerge, i18n.MessageMergeSuccess, fields, merged.Id)
+}
+
+func addMergedMember(ctx registry.CommandContext, ticket database.Ticket, userId uint64, additionalPermissions database.TicketPermissions) error {
+	if err := dbclient.Client.TicketMembers.Add(ctx, ctx.GuildId(), ticket.Id, userId); err != nil {
+		return err
+	}
+
+	if ticket.IsThread {
+		return ctx.Worker().AddThreadMember(*ticket.ChannelId, userId)
+	}
+
+	return ctx.Worker().EditChannelPermissions(*ticket.ChannelId, logic.BuildUserOverwrite(userId, additionalPermissions))
+}
+
+// AutoCompleteHandler suggests the other open tickets of the ticket's opener, as they are the most likely duplicates
+func (MergeCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, _ command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
+	if data.GuildId.Value == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3)
+	defer cancel()
+
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, data.ChannelId, data.GuildId.Value)
+	if err != nil {
+		fmt.Print(err)
+		return nil
+	}
+
+	if ticket.Id == 0 {
+		return nil
+	}
+
+	tickets, err := dbclient.Client.Tickets.GetOpenByUser(ctx, data.GuildId.Value, ticket.UserId)
+	if err != nil {
+		fmt.Print(err)
+		return nil
+	}
+
+	prefix := focused.String()
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, len(tickets))
+	for _, openTicket := range tickets {
+		id := strconv.Itoa(openTicket.Id)
+		if openTicket.Id == ticket.Id || !strings.HasPrefix(id, prefix) {
+			continue
+		}
+
+		choices = append(choices, interaction.ApplicationCommandOptionChoice{
+			Name:  id,
+			Value: openTicket.Id,
+		})
+	}
+
+	return choices
+}
@@ -25,6 +25,7 @@ func (NotesCommand) Properties() registry.Properties {
 	return registry.Properties{
 		Name:             "notes",
 		Description:      i18n.HelpNotes,
+		LongDescription:  i18n.HelpLongNotes,
 		Type:             interaction.ApplicationCommandTypeChatInput,
 		PermissionLevel:  permcache.Support,
 		Category:         command.Tickets,
@@ -55,17 +56,6 @@ func (NotesCommand) Execute(ctx registry.CommandContext) {
 		return
 	}
 
-	var panel *database.Panel
-	if ticket.PanelId != nil {
-		tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
-		if err != nil {
-			ctx.HandleError(err)
-			return
-		}
-
-		panel = &tmp
-	}
-
 	if ticket.NotesThreadId != nil {
 		// Check if user is staff member
 		// HasPermissionForTicket returns true if the user opened the ticket, but the command's properties enforces
@@ -88,71 +78,87 @@ func (NotesCommand) Execute(ctx registry.CommandContext) {
 
 		ctx.Reply(customisation.Green, i18n.Success, i18n.MessageNotesAddedToExisting, *ticket.NotesThreadId)
 	} else {
-		allowedUsers, allowedRoles, err := logic.GetAllowedStaffUsersAndRoles(ctx, ctx.GuildId(), panel)
+		threadId, err := createNotesThread(ctx, ticket)
 		if err != nil {
 			ctx.HandleError(err)
 			return
 		}
 
-		var b strings.Builder
-		b.Grow(utils.Min(len(allowedRoles)*22+len(allowedUsers)*21, 2000)) // Provide size hint
+		ctx.Reply(customisation.Green, i18n.Success, i18n.MessageNotesCreated, threadId)
+	}
+}
 
-		// Make sure user is added to the thread, as they may be the server owner but not have any of the staff roles
-		b.WriteString("<@" + strconv.FormatUint(ctx.UserId(), 10) + ">")
+// createNotesThread creates a private thread in the ticket channel for staff to discuss the ticket, and adds the staff
+// members who can see the ticket to it. Returns the ID of the thread.
+func createNotesThread(ctx registry.CommandContext, ticket database.Ticket) (uint64, error) {
+	var panel *database.Panel
+	if ticket.PanelId != nil {
+		tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+		if err != nil {
+			return 0, err
+		}
 
-		// Add roles first
-		for _, roleId := range allowedRoles {
-			mention := "<@&" + strconv.FormatUint(roleId, 10) + ">"
+		panel = &tmp
+	}
 
-			if b.Len()+len(mention) > 2000 {
-				break
-			}
+	allowedUsers, allowedRoles, err := logic.GetAllowedStaffUsersAndRoles(ctx, ctx.GuildId(), panel)
+	if err != nil {
+		return 0, err
+	}
 
-			_, _ = b.WriteString(mention) // Error is always nil
-		}
+	var b strings.Builder
+	b.Grow(utils.Min(len(allowedRoles)*22+len(allowedUsers)*21, 2000)) // Provide size hint
 
-		for _, roleId := range allowedUsers {
-			mention := "<@" + strconv.FormatUint(roleId, 10) + ">"
+	// Make sure user is added to the thread, as they may be the server owner but not have any of the staff roles
+	b.WriteString("<@" + strconv.FormatUint(ctx.UserId(), 10) + ">")
 
-			if b.Len()+len(mention) > 2000 {
-				break
-			}
+	// Add roles first
+	for _, roleId := range allowedRoles {
+		mention := "<@&" + strconv.FormatUint(roleId, 10) + ">"
 
-			_, _ = b.WriteString(mention) // Error is always nil
+		if b.Len()+len(mention) > 2000 {
+			break
 		}
 
-		editData := rest.EditMessageData{
-			Content: b.String(),
-		}
+		_, _ = b.WriteString(mention) // Error is always nil
+	}
 
-		thread, err := ctx.Worker().CreatePrivateThread(ctx.ChannelId(), ctx.GetMessage(i18n.MessageNotesThreadName), 10080, false)
-		if err != nil {
-			ctx.HandleError(err)
-			return
-		}
+	for _, roleId := range allowedUsers {
+		mention := "<@" + strconv.FormatUint(roleId, 10) + ">"
 
-		if err := dbclient.Client.Tickets.SetNotesThreadId(ctx, ticket.GuildId, ticket.Id, thread.Id); err != nil {
-			ctx.HandleError(err)
-			return
+		if b.Len()+len(mention) > 2000 {
+			break
 		}
 
-		// Add staff to thread
-		msg, err := ctx.Worker().CreateMessage(thread.Id, "Adding members...")
-		if err != nil {
-			ctx.HandleError(err)
-			return
-		}
+		_, _ = b.WriteString(mention) // Error is always nil
+	}
 
-		if _, err := ctx.Worker().EditMessage(thread.Id, msg.Id, editData); err != nil {
-			ctx.HandleError(err)
-			return
-		}
+	editData := rest.EditMessageData{
+		Content: b.String(),
+	}
 
-		if err := ctx.Worker().DeleteMessage(thread.Id, msg.Id); err != nil {
-			ctx.HandleError(err)
-			return
-		}
+	thread, err := ctx.Worker().CreatePrivateThread(ctx.ChannelId(), ctx.GetMessage(i18n.MessageNotesThreadName), 10080, false)
+	if err != nil {
+		return 0, err
+	}
 
-		ctx.Reply(customisation.Green, i18n.Success, i18n.MessageNotesCreated, thread.Id)
+	if err := dbclient.Client.Tickets.SetNotesThreadId(ctx, ticket.GuildId, ticket.Id, thread.Id); err != nil {
+		return 0, err
 	}
+
+	// Add staff to thread
+	msg, err := ctx.Worker().CreateMessage(thread.Id, "Adding members...")
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := ctx.Worker().EditMessage(thread.Id, msg.Id, editData); err != nil {
+		return 0, err
+	}
+
+	if err := ctx.Worker().DeleteMessage(thread.Id, msg.Id); err != nil {
+		return 0, err
+	}
+
+	return thread.Id, nil
 }
@@ -24,11 +24,13 @@ func (OnCallCommand) Properties() registry.Properties {
 	return registry.Properties{
 		Name:             "on-call",
 		Description:      i18n.HelpOnCall,
+		LongDescription:  i18n.HelpLongOnCall,
 		Type:             interaction.ApplicationCommandTypeChatInput,
 		PermissionLevel:  permcache.Support,
 		Category:         command.Tickets,
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 8,
+		Cooldown:         command.NewCooldown(command.CooldownScopeUser, 3, time.Minute),
 	}
 }
 
@@ -0,0 +1,115 @@
+package tickets
+
+import (
+	"errors"
+
+	"github.com/jadevelopmentgrp/Tickets-Database"
+	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/constants"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type OpenTicketForUserCommand struct {
+}
+
+func (OpenTicketForUserCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "Open Ticket For User",
+		Type:             interaction.ApplicationCommandTypeUser,
+		PermissionLevel:  permcache.Support,
+		Category:         command.Tickets,
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+		Timeout:          constants.TimeoutOpenTicket,
+	}
+}
+
+func (c OpenTicketForUserCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (OpenTicketForUserCommand) Execute(ctx registry.CommandContext) {
+	interaction, ok := ctx.(*context.SlashCommandContext)
+	if !ok {
+		return
+	}
+
+	targetId := interaction.Interaction.Data.TargetId
+
+	target, ok := interaction.ResolvedMember(targetId)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageInvalidUser)
+		return
+	}
+
+	// Members in resolved data do not carry the user object
+	user, ok := interaction.ResolvedUser(targetId)
+	if !ok {
+		ctx.HandleError(errors.New("User missing from resolved data"))
+		return
+	}
+
+	target.User = user
+
+	if user.Bot {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageOpenForUserBot)
+		return
+	}
+
+	permLevel, err := permcache.GetPermissionLevel(ctx, utils.ToRetriever(ctx.Worker()), target, ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	blacklisted, err := utils.IsBlacklisted(ctx, ctx.GuildId(), targetId, target, permLevel)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if blacklisted {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageOpenForUserBlacklisted, targetId)
+		return
+	}
+
+	settings, err := ctx.Settings()
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var panel *database.Panel
+	if settings.ContextMenuPanel != nil {
+		p, err := dbclient.Client.Panel.GetById(ctx, *settings.ContextMenuPanel)
+		if err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		panel = &p
+	}
+
+	// Open the ticket as the target, so that they are the ticket's opener and are subject to its limits
+	ticket, err := logic.OpenTicket(ctx, interaction.ActingAs(target), panel, "", nil)
+	if err != nil {
+		// Already handled
+		return
+	}
+
+	if ticket.ChannelId != nil {
+		msgEmbed := utils.BuildEmbed(ctx, customisation.Green, i18n.Ticket, i18n.MessageOpenedForUser, nil, ctx.UserId(), targetId)
+		if _, err := ctx.Worker().CreateMessageEmbed(*ticket.ChannelId, msgEmbed); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+	}
+}
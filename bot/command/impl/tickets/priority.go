@@ -0,0 +1,135 @@
+package tickets
+
+import (
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/rest"
+)
+
+type PriorityCommand struct {
+}
+
+func (PriorityCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "priority",
+		Description:     i18n.HelpPriority,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Arguments: command.Arguments(
+			command.NewRequiredArgument("priority", i18n.ArgumentPriorityPriority, interaction.OptionTypeString, i18n.MessagePriorityInvalid).
+				WithChoices(logic.PriorityChoices()...),
+		),
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c PriorityCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (PriorityCommand) Execute(ctx registry.CommandContext, priorityName string) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	// Check this is a ticket channel
+	if ticket.UserId == 0 {
+		ctx.Reply(customisation.Red, i18n.TitlePriority, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	priority, ok := logic.ParsePriority(priorityName)
+	if !ok {
+		ctx.Reply(customisation.Red, i18n.TitlePriority, i18n.MessagePriorityInvalid)
+		return
+	}
+
+	previousPriority, err := logic.GetTicketPriority(ctx, ticket)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if err := dbclient.Client.TicketPriorities.Set(ctx, ctx.GuildId(), ticket.Id, priority); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.ChannelId != nil && previousPriority != priority {
+		if err := updatePriorityChannelName(ctx, ticket, priority); err != nil {
+			ctx.HandleError(err)
+			return
+		}
+
+		// Let the status category machinery move the ticket into, or out of, the high priority category
+		if !ticket.IsThread && logic.IsHighPriority(previousPriority) != logic.IsHighPriority(priority) {
+			if err := dbclient.Client.CategoryUpdateQueue.Add(ctx, ctx.GuildId(), ticket.Id, ticket.Status); err != nil {
+				ctx.HandleError(err)
+				return
+			}
+		}
+	}
+
+	ctx.Reply(customisation.Green, i18n.TitlePriority, i18n.MessagePrioritySuccess, ctx.GetMessage(logic.PriorityName(priority)))
+}
+
+func updatePriorityChannelName(ctx registry.CommandContext, ticket database.Ticket, priority database.TicketPriority) error {
+	settings, err := dbclient.Client.PrioritySettings.Get(ctx, ctx.GuildId())
+	if err != nil {
+		return err
+	}
+
+	if !settings.ChannelPrefix {
+		return nil
+	}
+
+	// Renames share Discord's channel name ratelimit, so don't block the command waiting for it
+	allowed, err := redis.TakeRenameRatelimit(ctx, *ticket.ChannelId)
+	if err != nil {
+		return err
+	}
+
+	if !allowed {
+		return nil
+	}
+
+	var panel *database.Panel
+	if ticket.PanelId != nil {
+		tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
+		if err != nil {
+			return err
+		}
+
+		if tmp.GuildId != 0 {
+			panel = &tmp
+		}
+	}
+
+	claimer, err := dbclient.Client.TicketClaims.Get(ctx, ctx.GuildId(), ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	name, err := logic.GenerateChannelName(ctx, ctx, panel, ticket.Id, ticket.UserId, utils.NilIfZero(claimer), priority)
+	if err != nil {
+		return err
+	}
+
+	_, err = ctx.Worker().ModifyChannel(*ticket.ChannelId, rest.ModifyChannelData{Name: name})
+	return err
+}
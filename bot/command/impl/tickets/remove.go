@@ -27,7 +27,7 @@ func (RemoveCommand) Properties() registry.Properties {
 		PermissionLevel: permcache.Everyone,
 		Category:        command.Tickets,
 		Arguments: command.Arguments(
-			command.NewRequiredArgument("user", "User to remove from the current ticket", interaction.OptionTypeUser, i18n.MessageRemoveAdminNoMembers),
+			command.NewRequiredArgument("user", i18n.ArgumentRemoveUser, interaction.OptionTypeUser, i18n.MessageRemoveAdminNoMembers),
 		),
 		Timeout: time.Second * 8,
 	}
@@ -26,7 +26,7 @@ func (c ReopenCommand) Properties() registry.Properties {
 		PermissionLevel: permission.Everyone,
 		Category:        command.Tickets,
 		Arguments: command.Arguments(
-			command.NewRequiredAutocompleteableArgument("ticket_id", "ID of the ticket to reopen", interaction.OptionTypeInteger, i18n.MessageInvalidArgument, c.AutoCompleteHandler),
+			command.NewRequiredAutocompleteableArgument("ticket_id", i18n.ArgumentReopenTicketId, interaction.OptionTypeInteger, i18n.MessageInvalidArgument, c.AutoCompleteHandler),
 		),
 		DefaultEphemeral: true,
 		Timeout:          time.Second * 10,
@@ -41,7 +41,7 @@ func (ReopenCommand) Execute(ctx registry.CommandContext, ticketId int) {
 	logic.ReopenTicket(ctx, ctx, ticketId)
 }
 
-func (ReopenCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+func (ReopenCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, _ command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
 	if data.GuildId.Value == 0 {
 		return nil
 	}
@@ -53,7 +53,7 @@ func (ReopenCommand) AutoCompleteHandler(data interaction.ApplicationCommandAuto
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate contxet
 	defer cancel()
 
-	tickets, err := dbclient.Client.Tickets.GetClosedByUserPrefixed(ctx, data.GuildId.Value, data.Member.User.Id, value, 25)
+	tickets, err := dbclient.Client.Tickets.GetClosedByUserPrefixed(ctx, data.GuildId.Value, data.Member.User.Id, focused.String(), 25)
 	if err != nil {
 		fmt.Print(err)
 		return nil
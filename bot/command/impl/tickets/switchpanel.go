@@ -3,10 +3,8 @@ package tickets
 import (
 	"context"
 	"fmt"
-	"strings"
 	"time"
 
-	database "github.com/jadevelopmentgrp/Tickets-Database"
 	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
@@ -31,12 +29,13 @@ func (c SwitchPanelCommand) Properties() registry.Properties {
 	return registry.Properties{
 		Name:            "switchpanel",
 		Description:     i18n.HelpSwitchPanel,
+		LongDescription: i18n.HelpLongSwitchPanel,
 		Type:            interaction.ApplicationCommandTypeChatInput,
 		PermissionLevel: permission.Support,
 		Category:        command.Tickets,
 		InteractionOnly: true,
 		Arguments: command.Arguments(
-			command.NewRequiredAutocompleteableArgument("panel", "Ticket panel to switch the ticket to", interaction.OptionTypeInteger, i18n.MessageInvalidUser, c.AutoCompleteHandler), // TODO: Fix invalid message
+			command.NewRequiredAutocompleteableArgument("panel", i18n.ArgumentSwitchPanelPanel, interaction.OptionTypeInteger, i18n.MessageInvalidUser, c.AutoCompleteHandler), // TODO: Fix invalid message
 		),
 		Timeout: constants.TimeoutOpenTicket,
 	}
@@ -141,7 +140,13 @@ func (SwitchPanelCommand) Execute(ctx *cmdcontext.SlashCommandContext, panelId i
 	}
 
 	// Get new channel name
-	channelName, err := logic.GenerateChannelName(ctx.Context, ctx, &panel, ticket.Id, ticket.UserId, utils.NilIfZero(claimer))
+	priority, err := logic.GetTicketPriority(ctx, ticket)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	channelName, err := logic.GenerateChannelName(ctx.Context, ctx, &panel, ticket.Id, ticket.UserId, utils.NilIfZero(claimer), priority)
 	if err != nil {
 		ctx.HandleError(err)
 		return
@@ -229,7 +234,7 @@ func (SwitchPanelCommand) Execute(ctx *cmdcontext.SlashCommandContext, panelId i
 	ctx.ReplyPermanent(customisation.Green, i18n.TitlePanelSwitched, i18n.MessageSwitchPanelSuccess, panel.Title, ctx.UserId())
 }
 
-func (SwitchPanelCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, value string) []interaction.ApplicationCommandOptionChoice {
+func (SwitchPanelCommand) AutoCompleteHandler(data interaction.ApplicationCommandAutoCompleteInteraction, focused command.AutoCompleteValue, _ command.AutoCompleteOptions) []interaction.ApplicationCommandOptionChoice {
 	if data.GuildId.Value == 0 {
 		return nil
 	}
@@ -237,42 +242,11 @@ func (SwitchPanelCommand) AutoCompleteHandler(data interaction.ApplicationComman
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
 	defer cancel()
 
-	panels, err := dbclient.Client.Panel.GetByGuild(ctx, data.GuildId.Value)
+	choices, err := logic.PanelChoices(ctx, data.GuildId.Value, focused.String())
 	if err != nil {
 		fmt.Print(err) // TODO: Context
 		return nil
 	}
 
-	if value == "" {
-		if len(panels) > 25 {
-			return panelsToChoices(panels[:25])
-		} else {
-			return panelsToChoices(panels)
-		}
-	} else {
-		var filtered []database.Panel
-		for _, panel := range panels {
-			if strings.Contains(strings.ToLower(panel.Title), strings.ToLower(value)) {
-				filtered = append(filtered, panel)
-			}
-
-			if len(filtered) == 25 {
-				break
-			}
-		}
-
-		return panelsToChoices(filtered)
-	}
-}
-
-func panelsToChoices(panels []database.Panel) []interaction.ApplicationCommandOptionChoice {
-	choices := make([]interaction.ApplicationCommandOptionChoice, len(panels))
-	for i, panel := range panels {
-		choices[i] = interaction.ApplicationCommandOptionChoice{
-			Name:  panel.Title,
-			Value: panel.PanelId,
-		}
-	}
-
 	return choices
 }
@@ -0,0 +1,58 @@
+package tickets
+
+import (
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+type UnholdCommand struct {
+}
+
+func (UnholdCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:            "unhold",
+		Description:     i18n.HelpUnhold,
+		Type:            interaction.ApplicationCommandTypeChatInput,
+		PermissionLevel: permission.Support,
+		Category:        command.Tickets,
+		Timeout:         time.Second * 5,
+	}
+}
+
+func (c UnholdCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (UnholdCommand) Execute(ctx registry.CommandContext) {
+	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, ctx.ChannelId(), ctx.GuildId())
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if ticket.Id == 0 {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageNotATicketChannel)
+		return
+	}
+
+	resumed, err := logic.ResumeTicket(ctx, ticket)
+	if err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	if !resumed {
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageUnholdNotOnHold)
+		return
+	}
+
+	ctx.ReplyPermanent(customisation.Green, i18n.TitleResumed, i18n.MessageUnholdSuccess, ctx.UserId())
+}
@@ -0,0 +1,139 @@
+package tickets
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Database"
+	permcache "github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/interaction"
+	"golang.org/x/sync/errgroup"
+)
+
+type ViewTicketsCommand struct {
+}
+
+const viewTicketsClosedLimit = 10
+
+func (ViewTicketsCommand) Properties() registry.Properties {
+	return registry.Properties{
+		Name:             "View Tickets",
+		Type:             interaction.ApplicationCommandTypeUser,
+		PermissionLevel:  permcache.Support,
+		Category:         command.Tickets,
+		InteractionOnly:  true,
+		DefaultEphemeral: true,
+		Timeout:          time.Second * 5,
+	}
+}
+
+func (c ViewTicketsCommand) GetExecutor() interface{} {
+	return c.Execute
+}
+
+func (ViewTicketsCommand) Execute(ctx registry.CommandContext) {
+	interaction, ok := ctx.(*context.SlashCommandContext)
+	if !ok {
+		return
+	}
+
+	targetId := interaction.Interaction.Data.TargetId
+
+	var open, all []database.Ticket
+
+	group, _ := errgroup.WithContext(ctx)
+
+	group.Go(func() (err error) {
+		open, err = dbclient.Client.Tickets.GetOpenByUser(ctx, ctx.GuildId(), targetId)
+		return
+	})
+
+	group.Go(func() (err error) {
+		all, err = dbclient.Client.Tickets.GetAllByUser(ctx, ctx.GuildId(), targetId)
+		return
+	})
+
+	if err := group.Wait(); err != nil {
+		ctx.HandleError(err)
+		return
+	}
+
+	var closed []database.Ticket
+	for _, ticket := range all {
+		if !ticket.Open {
+			closed = append(closed, ticket)
+		}
+	}
+
+	// Most recent first
+	slices.SortFunc(open, func(a, b database.Ticket) int {
+		return b.Id - a.Id
+	})
+
+	slices.SortFunc(closed, func(a, b database.Ticket) int {
+		return b.Id - a.Id
+	})
+
+	if len(closed) > viewTicketsClosedLimit {
+		closed = closed[:viewTicketsClosedLimit]
+	}
+
+	fields := []embed.EmbedField{
+		utils.EmbedFieldRaw(ctx.GetMessage(i18n.MessageViewTicketsOpen, len(open)), formatOpenTickets(ctx, open), false),
+		utils.EmbedFieldRaw(ctx.GetMessage(i18n.MessageViewTicketsClosed), formatClosedTickets(ctx, closed), false),
+	}
+
+	ctx.ReplyWithFields(customisation.Green, i18n.Ticket, i18n.MessageViewTicketsDescription, fields, targetId)
+}
+
+func formatOpenTickets(ctx registry.CommandContext, tickets []database.Ticket) string {
+	if len(tickets) == 0 {
+		return ctx.GetMessage(i18n.MessageViewTicketsNone)
+	}
+
+	lines := make([]string, 0, len(tickets))
+	for _, ticket := range tickets {
+		line := fmt.Sprintf("#%d", ticket.Id)
+		if ticket.ChannelId != nil {
+			line += fmt.Sprintf(" <#%d>", *ticket.ChannelId)
+		}
+
+		lines = append(lines, fmt.Sprintf("%s <t:%d:R>", line, ticket.OpenTime.Unix()))
+	}
+
+	return utils.StringMax(strings.Join(lines, "\n"), 1024)
+}
+
+func formatClosedTickets(ctx registry.CommandContext, tickets []database.Ticket) string {
+	if len(tickets) == 0 {
+		return ctx.GetMessage(i18n.MessageViewTicketsNone)
+	}
+
+	lines := make([]string, 0, len(tickets))
+	for _, ticket := range tickets {
+		line := fmt.Sprintf("#%d", ticket.Id)
+		if ticket.HasTranscript {
+			line = fmt.Sprintf("[#%d](%s)", ticket.Id, logic.TranscriptUrl(ticket.GuildId, ticket.Id))
+		}
+
+		closedAt := ticket.OpenTime
+		if ticket.CloseTime != nil {
+			closedAt = *ticket.CloseTime
+		}
+
+		lines = append(lines, fmt.Sprintf("%s <t:%d:R>", line, closedAt.Unix()))
+	}
+
+	return utils.StringMax(strings.Join(lines, "\n"), 1024)
+}
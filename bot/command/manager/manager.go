@@ -1,6 +1,9 @@
 package manager
 
 import (
+	"strconv"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/general"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/settings"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/settings/setup"
@@ -10,7 +13,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
-	"github.com/rxdn/gdl/rest"
+	gdlpermission "github.com/rxdn/gdl/permission"
 )
 
 type CommandManager struct {
@@ -37,7 +40,7 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["panel"] = settings.PanelCommand{}
 	cm.registry["removeadmin"] = settings.RemoveAdminCommand{}
 	cm.registry["removesupport"] = settings.RemoveSupportCommand{}
-	cm.registry["setup"] = setup.SetupCommand{}
+	cm.registry["setup"] = setup.SetupCommand{Registry: cm.registry}
 	cm.registry["viewstaff"] = settings.ViewStaffCommand{}
 
 	cm.registry["stats"] = statistics.StatsCommand{}
@@ -49,16 +52,24 @@ func (cm *CommandManager) RegisterCommands() {
 	cm.registry["claim"] = tickets.ClaimCommand{}
 	cm.registry["close"] = tickets.CloseCommand{}
 	cm.registry["closerequest"] = tickets.CloseRequestCommand{}
+	cm.registry["hold"] = tickets.HoldCommand{}
+	cm.registry["merge"] = tickets.MergeCommand{}
 	cm.registry["notes"] = tickets.NotesCommand{}
 	cm.registry["on-call"] = tickets.OnCallCommand{}
 	cm.registry["open"] = tickets.OpenCommand{}
 	cm.registry["Start Ticket"] = tickets.StartTicketCommand{}
+	cm.registry["Open Ticket For User"] = tickets.OpenTicketForUserCommand{}
+	cm.registry["View Tickets"] = tickets.ViewTicketsCommand{}
+	cm.registry["Save As Tag"] = tags.SaveAsTagCommand{}
+	cm.registry["Add To Notes"] = tickets.AddToNotesCommand{}
+	cm.registry["priority"] = tickets.PriorityCommand{}
 	cm.registry["remove"] = tickets.RemoveCommand{}
 	cm.registry["rename"] = tickets.RenameCommand{}
 	cm.registry["reopen"] = tickets.ReopenCommand{}
 	cm.registry["switchpanel"] = tickets.SwitchPanelCommand{}
 	cm.registry["transfer"] = tickets.TransferCommand{}
 	cm.registry["unclaim"] = tickets.UnclaimCommand{}
+	cm.registry["unhold"] = tickets.UnholdCommand{}
 }
 
 func (cm *CommandManager) RunSetupFuncs() {
@@ -69,26 +80,47 @@ func (cm *CommandManager) RunSetupFuncs() {
 	}
 }
 
-func (cm *CommandManager) BuildCreatePayload() (data []rest.CreateCommandData) {
+// BuildCreatePayload builds the payload for registering the commands available to everyone. Commands restricted to bot
+// admins and helpers are excluded, see BuildAdminCreatePayload.
+func (cm *CommandManager) BuildCreatePayload() []CommandData {
+	return cm.buildCreatePayload(func(properties registry.Properties) bool {
+		return !properties.AdminOnly && !properties.HelperOnly
+	})
+}
+
+// BuildAdminCreatePayload builds the payload for registering the commands restricted to bot admins and helpers, which
+// are only registered in the admin guild.
+func (cm *CommandManager) BuildAdminCreatePayload() []CommandData {
+	return cm.buildCreatePayload(func(properties registry.Properties) bool {
+		return properties.AdminOnly || properties.HelperOnly
+	})
+}
+
+func (cm *CommandManager) buildCreatePayload(filter func(registry.Properties) bool) (data []CommandData) {
 	for _, cmd := range cm.GetCommands() {
 		properties := cmd.Properties()
 
-		if properties.MessageOnly {
+		if properties.MessageOnly || !filter(properties) {
 			continue
 		}
 
-		option := buildOption(cmd)
-
-		var description string
-		if properties.Type == interaction.ApplicationCommandTypeChatInput {
-			description = option.Description
+		option := buildOption(cmd, nil)
+
+		// Commands can only be run in guilds, as the permission level depends on the member
+		cmdData := CommandData{
+			Name:                     option.Name,
+			NameLocalizations:        option.NameLocalizations,
+			Options:                  option.Options,
+			Type:                     properties.Type,
+			DefaultMemberPermissions: defaultMemberPermissions(properties.PermissionLevel),
+			Contexts:                 []InteractionContextType{InteractionContextGuild},
+			IntegrationTypes:         []IntegrationType{IntegrationTypeGuildInstall},
 		}
 
-		cmdData := rest.CreateCommandData{
-			Name:        option.Name,
-			Description: description,
-			Options:     option.Options,
-			Type:        properties.Type,
+		// Context menu commands do not have descriptions
+		if properties.Type == interaction.ApplicationCommandTypeChatInput {
+			cmdData.Description = option.Description
+			cmdData.DescriptionLocalizations = option.DescriptionLocalizations
 		}
 
 		data = append(data, cmdData)
@@ -97,19 +129,33 @@ func (cm *CommandManager) BuildCreatePayload() (data []rest.CreateCommandData) {
 	return data
 }
 
-func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
+// defaultMemberPermissions returns the permissions that members need for the command to be shown to them, unless the
+// guild changes it in its integration settings. Support representatives are configured through the bot rather than
+// through Discord permissions, so only admin commands are hidden.
+func defaultMemberPermissions(level permission.PermissionLevel) *string {
+	if level < permission.Admin {
+		return nil
+	}
+
+	permissions := strconv.FormatUint(gdlpermission.BuildPermissions(gdlpermission.ManageGuild), 10)
+	return &permissions
+}
+
+// buildOption builds the option for a command or subcommand. parents contains the names of the commands above it.
+func buildOption(cmd registry.Command, parents []string) CommandOption {
 	properties := cmd.Properties()
+	path := append(parents[:len(parents):len(parents)], properties.Name)
 
 	// Required args must come before optional args
-	var required []interaction.ApplicationCommandOption
-	var optional []interaction.ApplicationCommandOption
+	var required []CommandOption
+	var optional []CommandOption
 
 	for _, child := range properties.Children {
 		if child.Properties().MessageOnly {
 			continue
 		}
 
-		option := buildOption(child)
+		option := buildOption(child, path)
 
 		if option.Required {
 			required = append(required, option)
@@ -119,15 +165,22 @@ func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
 	}
 
 	for _, argument := range properties.Arguments {
-		option := interaction.ApplicationCommandOption{
-			Type:         argument.Type,
-			Name:         argument.Name,
-			Description:  argument.Description,
-			Default:      false,
-			Required:     argument.Required,
-			Choices:      nil,
-			Autocomplete: argument.AutoCompleteHandler != nil,
-			Options:      nil,
+		option := CommandOption{
+			Type:                     argument.Type,
+			Name:                     argument.Name,
+			NameLocalizations:        nameLocalisations(append(path[:len(path):len(path)], argument.Name), true),
+			Description:              i18n.GetMessage(i18n.LocaleEnglish, argument.Description),
+			DescriptionLocalizations: descriptionLocalisations(argument.Description),
+			Default:                  false,
+			Required:                 argument.Required,
+			Choices:                  argument.Choices,
+			Autocomplete:             argument.AutoCompleteHandler != nil,
+			Options:                  nil,
+			ChannelTypes:             argument.ChannelTypes,
+			MinValue:                 argument.MinValue,
+			MaxValue:                 argument.MaxValue,
+			MinLength:                argument.MinLength,
+			MaxLength:                argument.MaxLength,
 		}
 
 		if option.Required {
@@ -139,13 +192,15 @@ func buildOption(cmd registry.Command) interaction.ApplicationCommandOption {
 
 	options := append(required, optional...)
 
-	return interaction.ApplicationCommandOption{
-		Type:        interaction.OptionTypeSubCommand,
-		Name:        properties.Name,
-		Description: i18n.GetMessage(i18n.LocaleEnglish, properties.Description),
-		Default:     false,
-		Required:    false,
-		Choices:     nil,
-		Options:     options,
+	return CommandOption{
+		Type:                     interaction.OptionTypeSubCommand,
+		Name:                     properties.Name,
+		NameLocalizations:        nameLocalisations(path, properties.Type == interaction.ApplicationCommandTypeChatInput),
+		Description:              i18n.GetMessage(i18n.LocaleEnglish, properties.Description),
+		DescriptionLocalizations: descriptionLocalisations(properties.Description),
+		Default:                  false,
+		Required:                 false,
+		Choices:                  nil,
+		Options:                  options,
 	}
 }
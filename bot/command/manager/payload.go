@@ -0,0 +1,172 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"github.com/rxdn/gdl/rest/request"
+)
+
+// CommandData is the payload used to register a command. gdl's rest.CreateCommandData does not support localisations,
+// so commands are registered using this type instead.
+type CommandData struct {
+	Id                       uint64                             `json:"id,omitempty"` // Optional: Use to rename without changing ID
+	Name                     string                             `json:"name"`
+	NameLocalizations        map[string]string                  `json:"name_localizations,omitempty"`
+	Description              string                             `json:"description"`
+	DescriptionLocalizations map[string]string                  `json:"description_localizations,omitempty"`
+	Options                  []CommandOption                    `json:"options"`
+	Type                     interaction.ApplicationCommandType `json:"type"`
+	DefaultMemberPermissions *string                            `json:"default_member_permissions,omitempty"`
+	Contexts                 []InteractionContextType           `json:"contexts,omitempty"`
+	IntegrationTypes         []IntegrationType                  `json:"integration_types,omitempty"`
+}
+
+// https://discord.com/developers/docs/interactions/receiving-and-responding#interaction-object-interaction-context-types
+type InteractionContextType int
+
+const (
+	InteractionContextGuild InteractionContextType = iota
+	InteractionContextBotDM
+	InteractionContextPrivateChannel
+)
+
+// https://discord.com/developers/docs/resources/application#application-object-application-integration-types
+type IntegrationType int
+
+const (
+	IntegrationTypeGuildInstall IntegrationType = iota
+	IntegrationTypeUserInstall
+)
+
+type CommandOption struct {
+	Type                     interaction.ApplicationCommandOptionType     `json:"type"`
+	Name                     string                                       `json:"name"`
+	NameLocalizations        map[string]string                            `json:"name_localizations,omitempty"`
+	Description              string                                       `json:"description"`
+	DescriptionLocalizations map[string]string                            `json:"description_localizations,omitempty"`
+	Default                  bool                                         `json:"default"`
+	Required                 bool                                         `json:"required"`
+	Choices                  []interaction.ApplicationCommandOptionChoice `json:"choices,omitempty"`
+	Autocomplete             bool                                         `json:"autocomplete"`
+	Options                  []CommandOption                              `json:"options,omitempty"`
+	ChannelTypes             []channel.ChannelType                        `json:"channel_types,omitempty"`
+	MinValue                 *float64                                     `json:"min_value,omitempty"`
+	MaxValue                 *float64                                     `json:"max_value,omitempty"`
+	MinLength                *int                                         `json:"min_length,omitempty"`
+	MaxLength                *int                                         `json:"max_length,omitempty"`
+}
+
+const maxDescriptionLength = 100
+
+// https://discord.com/developers/docs/interactions/application-commands#application-command-object-application-command-naming
+var chatInputNamePattern = regexp.MustCompile(`^[-_\p{L}\p{N}\p{Devanagari}\p{Thai}]{1,32}$`)
+
+// nameLocalisations returns the translations of the name of the command or argument at the given path. Translations
+// of chat input command names that Discord would reject are left out, so that they do not prevent the rest of the
+// commands from being registered.
+func nameLocalisations(path []string, chatInput bool) map[string]string {
+	key := strings.ToLower(strings.ReplaceAll(strings.Join(path, "."), " ", "_"))
+
+	localisations := i18n.GetLocalisations(i18n.MessageId(fmt.Sprintf("command_names.%s", key)))
+	for locale, name := range localisations {
+		if chatInput {
+			name = strings.ToLower(name)
+			if !chatInputNamePattern.MatchString(name) {
+				delete(localisations, locale)
+				continue
+			}
+		} else if utf8.RuneCountInString(name) > 32 {
+			delete(localisations, locale)
+			continue
+		}
+
+		localisations[locale] = name
+	}
+
+	return emptyToNil(localisations)
+}
+
+// descriptionLocalisations returns the translations of the description. Translations that are too long are left out,
+// so that English is used instead.
+func descriptionLocalisations(id i18n.MessageId) map[string]string {
+	localisations := i18n.GetLocalisations(id)
+	for locale, description := range localisations {
+		if utf8.RuneCountInString(description) > maxDescriptionLength {
+			delete(localisations, locale)
+		}
+	}
+
+	return emptyToNil(localisations)
+}
+
+func emptyToNil(m map[string]string) map[string]string {
+	if len(m) == 0 {
+		return nil
+	}
+
+	return m
+}
+
+// GetGlobalCommands returns the application's global commands, including their localisations
+func GetGlobalCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId uint64) (commands []CommandData, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.GET,
+		ContentType: request.Nil,
+		Endpoint:    fmt.Sprintf("/applications/%d/commands?with_localizations=true", applicationId),
+		Route:       ratelimit.NewApplicationRoute(ratelimit.RouteGetGlobalCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, nil, &commands)
+	return
+}
+
+// GetGuildCommands returns the application's commands in the guild, including their localisations
+func GetGuildCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId, guildId uint64) (commands []CommandData, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.GET,
+		ContentType: request.Nil,
+		Endpoint:    fmt.Sprintf("/applications/%d/guilds/%d/commands?with_localizations=true", applicationId, guildId),
+		Route:       ratelimit.NewGuildRoute(ratelimit.RouteGetGuildCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, nil, &commands)
+	return
+}
+
+// ModifyGlobalCommands overwrites the application's global commands
+func ModifyGlobalCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId uint64, data []CommandData) (commands []interaction.ApplicationCommand, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.PUT,
+		ContentType: request.ApplicationJson,
+		Endpoint:    fmt.Sprintf("/applications/%d/commands", applicationId),
+		Route:       ratelimit.NewApplicationRoute(ratelimit.RouteModifyGlobalCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, data, &commands)
+	return
+}
+
+// ModifyGuildCommands overwrites the application's commands in the guild
+func ModifyGuildCommands(ctx context.Context, token string, rateLimiter *ratelimit.Ratelimiter, applicationId, guildId uint64, data []CommandData) (commands []interaction.ApplicationCommand, err error) {
+	endpoint := request.Endpoint{
+		RequestType: request.PUT,
+		ContentType: request.ApplicationJson,
+		Endpoint:    fmt.Sprintf("/applications/%d/guilds/%d/commands", applicationId, guildId),
+		Route:       ratelimit.NewGuildRoute(ratelimit.RouteModifyGuildCommands, applicationId),
+		RateLimiter: rateLimiter,
+	}
+
+	err, _ = endpoint.Request(ctx, token, data, &commands)
+	return
+}
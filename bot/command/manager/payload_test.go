@@ -0,0 +1,65 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/stretchr/testify/require"
+)
+
+func withGermanMessages(t *testing.T, messages map[i18n.MessageId]string) {
+	i18n.SeedIndices()
+
+	german := i18n.DiscordLocales["de"]
+	previous := german.Messages
+	german.Messages = messages
+
+	t.Cleanup(func() {
+		german.Messages = previous
+	})
+}
+
+func TestNameLocalisations(t *testing.T) {
+	withGermanMessages(t, map[i18n.MessageId]string{
+		"command_names.close":        "Schließen",
+		"command_names.close.reason": "grund des schließens", // Spaces are not allowed
+	})
+
+	require.Equal(t, map[string]string{"de": "schließen"}, nameLocalisations([]string{"close"}, true))
+	require.Nil(t, nameLocalisations([]string{"close", "reason"}, true))
+}
+
+func TestDescriptionLocalisationsTooLong(t *testing.T) {
+	withGermanMessages(t, map[i18n.MessageId]string{
+		i18n.ArgumentCloseReason: "Der Grund, aus dem das Ticket geschlossen wurde, der so lang ist, dass Discord ihn nicht akzeptieren würde",
+		i18n.ArgumentOpenSubject: "Der Betreff des Tickets",
+	})
+
+	require.Nil(t, descriptionLocalisations(i18n.ArgumentCloseReason))
+	require.Equal(t, map[string]string{"de": "Der Betreff des Tickets"}, descriptionLocalisations(i18n.ArgumentOpenSubject))
+}
+
+func TestOnlyAdminCommandsAreHidden(t *testing.T) {
+	require.Nil(t, defaultMemberPermissions(permission.Everyone))
+	require.Nil(t, defaultMemberPermissions(permission.Support))
+	require.Equal(t, "32", *defaultMemberPermissions(permission.Admin)) // Manage Server
+}
+
+func TestPayloadHashIgnoresOrder(t *testing.T) {
+	a := CommandData{Name: "close", Type: interaction.ApplicationCommandTypeChatInput}
+	b := CommandData{Name: "close", Type: interaction.ApplicationCommandTypeMessage}
+	c := CommandData{Name: "add", Type: interaction.ApplicationCommandTypeChatInput}
+
+	first, err := PayloadHash([]CommandData{a, b, c})
+	require.NoError(t, err)
+
+	second, err := PayloadHash([]CommandData{c, b, a})
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+
+	changed, err := PayloadHash([]CommandData{a, b})
+	require.NoError(t, err)
+	require.NotEqual(t, first, changed)
+}
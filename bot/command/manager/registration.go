@@ -0,0 +1,54 @@
+package manager
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	worker "github.com/jadevelopmentgrp/Tickets-Worker"
+)
+
+// PayloadHash returns a hash of the commands, used to detect whether a bot's commands were registered from an older
+// version of the payload. The order of the commands does not affect the hash.
+func PayloadHash(data []CommandData) (string, error) {
+	sorted := slices.Clone(data)
+	slices.SortFunc(sorted, func(a, b CommandData) int {
+		if a.Type != b.Type {
+			return int(a.Type) - int(b.Type)
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	marshalled, err := json.Marshal(sorted)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(marshalled)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// RegisterGlobalCommands overwrites the bot's global commands with the payload. Existing commands that are not part of
+// the payload are kept, so that commands created by other means are not deleted.
+func RegisterGlobalCommands(ctx context.Context, worker *worker.Context, data []CommandData) error {
+	existing, err := GetGlobalCommands(ctx, worker.Token, worker.RateLimiter, worker.BotId)
+	if err != nil {
+		return err
+	}
+
+	payload := slices.Clone(data)
+	for _, cmd := range existing {
+		if !slices.ContainsFunc(data, func(desired CommandData) bool {
+			return desired.Name == cmd.Name && desired.Type == cmd.Type
+		}) {
+			payload = append(payload, cmd)
+		}
+	}
+
+	_, err = ModifyGlobalCommands(ctx, worker.Token, worker.RateLimiter, worker.BotId, payload)
+	return err
+}
@@ -12,6 +12,7 @@ import (
 type Properties struct {
 	Name             string
 	Description      i18n.MessageId
+	LongDescription  i18n.MessageId // Shown by /help for the command, falling back to Description if not set
 	Type             interaction.ApplicationCommandType
 	Aliases          []string
 	PermissionLevel  permission.PermissionLevel
@@ -24,7 +25,9 @@ type Properties struct {
 	MainBotOnly      bool
 	Arguments        []command.Argument
 	DefaultEphemeral bool
+	ModalResponse    bool // The command may respond with a modal, so the interaction is not deferred until it has run
 	Timeout          time.Duration
+	Cooldown         *command.Cooldown
 
 	SetupFunc func()
 }
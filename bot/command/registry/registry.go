@@ -1,3 +1,32 @@
 package registry
 
 type Registry map[string]Command
+
+// Find returns the command at the path, such as ["managetags", "add"]
+func (r Registry) Find(path []string) (Command, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+
+	cmd, ok := r[path[0]]
+	if !ok {
+		return nil, false
+	}
+
+	for _, name := range path[1:] {
+		var found bool
+		for _, child := range cmd.Properties().Children {
+			if child.Properties().Name == name {
+				cmd = child
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, false
+		}
+	}
+
+	return cmd, true
+}
@@ -0,0 +1,62 @@
+package commandpermission
+
+import (
+	"context"
+	"strings"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/rxdn/gdl/objects/member"
+)
+
+// Overrides maps command paths, such as "managetags add", to the guild's override of who may run the command
+type Overrides map[string]database.CommandPermissionOverride
+
+// Requirement describes who may run a command: users with at least Level, or any of the roles in RoleIds
+type Requirement struct {
+	Level   permission.PermissionLevel
+	RoleIds []uint64
+}
+
+func Get(ctx context.Context, guildId uint64) (Overrides, error) {
+	return dbclient.Client.CommandPermissionOverrides.GetAll(ctx, guildId)
+}
+
+// Requirement returns who may run the command at the path. The most specific override applies, so an override for a
+// parent command also applies to its subcommands, unless they are overridden themselves.
+func (o Overrides) Requirement(path []string, defaultLevel permission.PermissionLevel) Requirement {
+	for i := len(path); i > 0; i-- {
+		override, ok := o[strings.Join(path[:i], " ")]
+		if !ok {
+			continue
+		}
+
+		requirement := Requirement{
+			Level:   defaultLevel,
+			RoleIds: override.RoleIds,
+		}
+
+		if override.PermissionLevel != nil {
+			requirement.Level = permission.PermissionLevel(*override.PermissionLevel)
+		}
+
+		return requirement
+	}
+
+	return Requirement{Level: defaultLevel}
+}
+
+func (r Requirement) IsMet(permLevel permission.PermissionLevel, member member.Member) bool {
+	if permLevel >= r.Level {
+		return true
+	}
+
+	for _, roleId := range r.RoleIds {
+		if member.HasRole(roleId) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,34 @@
+package commandpermission
+
+import (
+	"testing"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/rxdn/gdl/objects/member"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMostSpecificOverrideApplies(t *testing.T) {
+	overrides := Overrides{
+		"managetags":     {Command: "managetags", PermissionLevel: utils.Ptr(int(permission.Admin))},
+		"managetags add": {Command: "managetags add", PermissionLevel: utils.Ptr(int(permission.Everyone))},
+	}
+
+	require.Equal(t, permission.Everyone, overrides.Requirement([]string{"managetags", "add"}, permission.Support).Level)
+	require.Equal(t, permission.Admin, overrides.Requirement([]string{"managetags", "delete"}, permission.Support).Level)
+	require.Equal(t, permission.Support, overrides.Requirement([]string{"rename"}, permission.Support).Level)
+}
+
+func TestRoleAllowlist(t *testing.T) {
+	const roleId = 1057286493718937601
+
+	overrides := Overrides{
+		"rename": {Command: "rename", RoleIds: []uint64{roleId}},
+	}
+
+	requirement := overrides.Requirement([]string{"rename"}, permission.Support)
+	require.True(t, requirement.IsMet(permission.Everyone, member.Member{Roles: []uint64{roleId}}))
+	require.False(t, requirement.IsMet(permission.Everyone, member.Member{}))
+	require.True(t, requirement.IsMet(permission.Support, member.Member{}))
+}
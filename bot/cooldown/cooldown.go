@@ -0,0 +1,38 @@
+package cooldown
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+)
+
+// Take uses the cooldown, replying to the user and returning false if it has been exhausted. Staff bypass cooldowns,
+// which are intended to stop users spamming commands. If Redis is unavailable, the command is allowed to run.
+func Take(ctx registry.CommandContext, name string, cooldown *command.Cooldown, permLevel permission.PermissionLevel) bool {
+	if cooldown == nil || permLevel >= permission.Support {
+		return true
+	}
+
+	key := cooldown.Key(name, ctx.GuildId(), ctx.ChannelId(), ctx.UserId())
+
+	allowed, retryAfter, err := redis.TakeCooldownToken(ctx, key, cooldown.Uses, cooldown.Interval)
+	if err != nil {
+		fmt.Print(err, ctx.ToErrorContext())
+		return true
+	}
+
+	if !allowed {
+		// Discord renders relative timestamps in the user's own language
+		retryAt := time.Now().Add(retryAfter).Add(time.Second - 1).Truncate(time.Second)
+		ctx.Reply(customisation.Red, i18n.Error, i18n.MessageCooldown, fmt.Sprintf("<t:%d:R>", retryAt.Unix()))
+		return false
+	}
+
+	return true
+}
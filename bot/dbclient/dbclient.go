@@ -7,11 +7,15 @@ import (
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/tracing"
 	"github.com/jadevelopmentgrp/Tickets-Worker/config"
 	"go.uber.org/zap"
 )
 
-var Client *database.Database
+var (
+	Client *database.Database
+	pool   *pgxpool.Pool
+)
 
 func Connect() {
 	logger := zap.NewExample()
@@ -34,9 +38,14 @@ func Connect() {
 	}
 
 	cfg.ConnConfig.LogLevel = pgx.LogLevelWarn
-	cfg.ConnConfig.Logger = NewLogAdapter(logger)
+	if tracing.Enabled() {
+		// pgx logs each query at info level, with its duration, which is used to record a span for it
+		cfg.ConnConfig.LogLevel = pgx.LogLevelInfo
+	}
+
+	cfg.ConnConfig.Logger = NewLogAdapter(logger, pgx.LogLevelWarn)
 
-	pool, err := pgxpool.ConnectConfig(context.Background(), cfg)
+	pool, err = pgxpool.ConnectConfig(context.Background(), cfg)
 	if err != nil {
 		logger.Fatal("Failed to connect to database", zap.Error(err))
 		return
@@ -44,3 +53,7 @@ func Connect() {
 
 	Client = database.NewDatabase(pool)
 }
+
+func Ping(ctx context.Context) error {
+	return pool.Ping(ctx)
+}
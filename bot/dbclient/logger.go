@@ -2,25 +2,67 @@ package dbclient
 
 import (
 	"context"
+	"errors"
+	"strings"
+	"time"
+
 	"github.com/jackc/pgx/v4"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
 
 type LogAdapter struct {
 	logger *zap.Logger
+	level  pgx.LogLevel
 }
 
 var _ pgx.Logger = (*LogAdapter)(nil)
 
-func NewLogAdapter(logger *zap.Logger) *LogAdapter {
+// NewLogAdapter returns a pgx logger which writes messages at the given level or more severe to the zap logger. Less
+// severe messages are only used to record spans for queries.
+func NewLogAdapter(logger *zap.Logger, level pgx.LogLevel) *LogAdapter {
 	return &LogAdapter{
 		logger: logger,
+		level:  level,
 	}
 }
 
 func (l *LogAdapter) Log(ctx context.Context, level pgx.LogLevel, msg string, data map[string]interface{}) {
-	l.logger.Log(pgxLevelToZapLevel(level), msg, toZapFields(data)...)
+	if tracing.Enabled() {
+		recordQuerySpan(ctx, msg, data)
+	}
+
+	if level <= l.level {
+		l.logger.Log(pgxLevelToZapLevel(level), msg, toZapFields(data)...)
+	}
+}
+
+// recordQuerySpan records a span for a query once it has completed, using the duration logged by pgx
+func recordQuerySpan(ctx context.Context, msg string, data map[string]interface{}) {
+	took, ok := data["time"].(time.Duration)
+	if !ok || !tracing.HasSpan(ctx) {
+		return
+	}
+
+	_, span := tracing.Tracer.Start(ctx, "db."+strings.ToLower(msg),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(time.Now().Add(-took)),
+		trace.WithAttributes(attribute.String("db.system", "postgresql")),
+	)
+	defer span.End()
+
+	if sql, ok := data["sql"].(string); ok {
+		span.SetAttributes(attribute.String("db.statement", sql))
+	}
+
+	if err, ok := data["err"].(error); ok {
+		tracing.RecordError(span, err)
+	} else if errMsg, ok := data["err"].(string); ok {
+		tracing.RecordError(span, errors.New(errMsg))
+	}
 }
 
 func pgxLevelToZapLevel(level pgx.LogLevel) zapcore.Level {
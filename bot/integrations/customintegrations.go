@@ -12,8 +12,11 @@ import (
 
 	"github.com/jadevelopmentgrp/Tickets-Database"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/tracing"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -49,6 +52,12 @@ func Fetch(
 ) (map[string]string, error) {
 	prometheus.LogIntegrationRequest(integration, ticket.GuildId)
 
+	ctx, span := tracing.Tracer.Start(ctx, "integrations.Fetch", trace.WithAttributes(
+		attribute.Int("integration.id", integration.Id),
+		attribute.String("http.method", integration.HttpMethod),
+	))
+	defer span.End()
+
 	url := strings.ReplaceAll(integration.WebhookUrl, "%user_id%", strconv.FormatUint(ticket.UserId, 10))
 	url = strings.ReplaceAll(url, "%guild_id%", strconv.FormatUint(ticket.GuildId, 10))
 	for _, secret := range secrets {
@@ -91,6 +100,7 @@ func Fetch(
 
 	res, err := SecureProxy.DoRequest(ctx, integration.HttpMethod, url, headerMap, body)
 	if err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 
@@ -99,6 +109,7 @@ func Fetch(
 
 	var jsonBody map[string]any
 	if err := decoder.Decode(&jsonBody); err != nil {
+		tracing.RecordError(span, err)
 		return nil, err
 	}
 
@@ -2,7 +2,6 @@ package listeners
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
@@ -10,23 +9,20 @@ import (
 	"github.com/rxdn/gdl/gateway/payloads/events"
 )
 
-func OnChannelDelete(worker *worker.Context, e events.ChannelDelete) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+func OnChannelDelete(worker *worker.Context, e events.ChannelDelete) error {
+	ctx, cancel := context.WithTimeout(worker.BaseContext(), time.Second*3)
 	defer cancel()
 
 	// If this is a ticket channel, close it
-
 	if err := dbclient.Client.Tickets.CloseByChannel(ctx, e.Id); err != nil {
-		fmt.Print(err)
+		return err
 	}
 
 	// if this is a channel category, delete it
 	if err := dbclient.Client.ChannelCategory.DeleteByChannel(ctx, e.Id); err != nil {
-		fmt.Print(err)
+		return err
 	}
 
 	// if this is an archive channel, delete it
-	if err := dbclient.Client.ArchiveChannel.DeleteByChannel(ctx, e.Id); err != nil {
-		fmt.Print(err)
-	}
+	return dbclient.Client.ArchiveChannel.DeleteByChannel(ctx, e.Id)
 }
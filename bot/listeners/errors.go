@@ -0,0 +1,36 @@
+package listeners
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var (
+	// ErrListenerFailed is wrapped by the error returned by HandleEvent when one or more listeners returned an error.
+	// The event was decoded successfully, so it may succeed if retried.
+	ErrListenerFailed = errors.New("event listener failed")
+
+	// ErrInvalidEvent is wrapped by the error returned by HandleEvent when the event could not be decoded, or is of an
+	// unknown type. Handling the event again will never succeed.
+	ErrInvalidEvent = errors.New("invalid event")
+)
+
+// ListenerError is returned by HandleEvent when one or more listeners returned an error. Failed holds the index of
+// each listener that failed, so that the listeners which succeeded are not run again when the event is retried.
+type ListenerError struct {
+	Failed []int
+	Err    error
+}
+
+func (e *ListenerError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrListenerFailed.Error(), e.Err.Error())
+}
+
+func (e *ListenerError) Unwrap() []error {
+	return []error{ErrListenerFailed, e.Err}
+}
+
+func shouldRun(only []int, index int) bool {
+	return only == nil || slices.Contains(only, index)
+}
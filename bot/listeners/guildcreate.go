@@ -19,8 +19,8 @@ import (
 )
 
 // Fires when we receive a guild
-func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*6) // TODO: Propagate context
+func OnGuildCreate(worker *worker.Context, e events.GuildCreate) error {
+	ctx, cancel := context.WithTimeout(worker.BaseContext(), time.Second*6)
 	defer cancel()
 
 	// check if guild is blacklisted
@@ -29,7 +29,7 @@ func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
 			fmt.Print(err)
 		}
 
-		return
+		return nil
 	}
 
 	if time.Now().Sub(e.JoinedAt) < time.Minute {
@@ -43,7 +43,7 @@ func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
 		}
 
 		if err := dbclient.Client.GuildLeaveTime.Delete(ctx, e.Guild.Id); err != nil {
-			fmt.Print(err)
+			return err
 		}
 
 		// Add roles with Administrator permission as bot admins by default
@@ -55,11 +55,13 @@ func OnGuildCreate(worker *worker.Context, e events.GuildCreate) {
 
 			if permission.HasPermissionRaw(role.Permissions, permission.Administrator) {
 				if err := dbclient.Client.RolePermissions.AddAdmin(ctx, e.Guild.Id, role.Id); err != nil { // TODO: Bulk
-					fmt.Print(err)
+					return err
 				}
 			}
 		}
 	}
+
+	return nil
 }
 
 func sendIntroMessage(ctx context.Context, worker *worker.Context, guild guild.Guild, userId uint64) {
@@ -2,7 +2,6 @@ package listeners
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
@@ -16,8 +15,8 @@ import (
  * The inner payload is an unavailable guild object.
  * If the unavailable field is not set, the user was removed from the guild.
  */
-func OnGuildLeave(worker *worker.Context, e events.GuildDelete) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+func OnGuildLeave(worker *worker.Context, e events.GuildDelete) error {
+	ctx, cancel := context.WithTimeout(worker.BaseContext(), time.Second*3)
 	defer cancel()
 
 	if e.Unavailable == nil {
@@ -25,11 +24,13 @@ func OnGuildLeave(worker *worker.Context, e events.GuildDelete) {
 
 		// Exclude from autoclose
 		if err := dbclient.Client.AutoCloseExclude.ExcludeAll(ctx, e.Guild.Id); err != nil {
-			fmt.Print(err)
+			return err
 		}
 
 		if err := dbclient.Client.GuildLeaveTime.Set(ctx, e.Guild.Id); err != nil {
-			fmt.Print(err)
+			return err
 		}
 	}
+
+	return nil
 }
@@ -17,54 +17,56 @@ import (
 )
 
 // Remove user permissions when they leave
-func OnMemberLeave(worker *worker.Context, e events.GuildMemberRemove) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+func OnMemberLeave(worker *worker.Context, e events.GuildMemberRemove) error {
+	ctx, cancel := context.WithTimeout(worker.BaseContext(), time.Second*3)
 	defer cancel()
 
 	if err := dbclient.Client.Permissions.RemoveSupport(ctx, e.GuildId, e.User.Id); err != nil {
-		fmt.Print(err)
+		return err
 	}
 
 	if err := utils.ToRetriever(worker).Cache().DeleteCachedPermissionLevel(ctx, e.GuildId, e.User.Id); err != nil {
-		fmt.Print(err)
+		return err
 	}
 
 	// auto close
 	settings, err := dbclient.Client.AutoClose.Get(ctx, e.GuildId)
 	if err != nil {
-		fmt.Print(err)
-	} else {
-		// check setting is enabled
-		if settings.Enabled && settings.OnUserLeave != nil && *settings.OnUserLeave {
-			// get open tickets by user
-			tickets, err := dbclient.Client.Tickets.GetOpenByUser(ctx, e.GuildId, e.User.Id)
+		return err
+	}
+
+	// check setting is enabled
+	if settings.Enabled && settings.OnUserLeave != nil && *settings.OnUserLeave {
+		// get open tickets by user
+		tickets, err := dbclient.Client.Tickets.GetOpenByUser(ctx, e.GuildId, e.User.Id)
+		if err != nil {
+			return err
+		}
+
+		for _, ticket := range tickets {
+			isExcluded, err := dbclient.Client.AutoCloseExclude.IsExcluded(ctx, e.GuildId, ticket.Id)
 			if err != nil {
 				fmt.Print(err)
-			} else {
-				for _, ticket := range tickets {
-					isExcluded, err := dbclient.Client.AutoCloseExclude.IsExcluded(ctx, e.GuildId, ticket.Id)
-					if err != nil {
-						fmt.Print(err)
-						continue
-					}
+				continue
+			}
 
-					if isExcluded {
-						continue
-					}
+			if isExcluded {
+				continue
+			}
 
-					// verify ticket exists + prevent potential panic
-					if ticket.ChannelId == nil {
-						return
-					}
+			// verify ticket exists + prevent potential panic
+			if ticket.ChannelId == nil {
+				return nil
+			}
 
-					ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
+			ctx, cancel := context.WithTimeout(worker.BaseContext(), constants.TimeoutCloseTicket)
 
-					cc := cmdcontext.NewAutoCloseContext(ctx, worker, e.GuildId, *ticket.ChannelId, worker.BotId)
-					logic.CloseTicket(ctx, cc, gdlUtils.StrPtr(messagequeue.AutoCloseReason), true)
+			cc := cmdcontext.NewAutoCloseContext(ctx, worker, e.GuildId, *ticket.ChannelId, worker.BotId)
+			logic.CloseTicket(ctx, cc, gdlUtils.StrPtr(messagequeue.AutoCloseReason), true)
 
-					cancel()
-				}
-			}
+			cancel()
 		}
 	}
+
+	return nil
 }
@@ -2,7 +2,6 @@ package listeners
 
 import (
 	"context"
-	"fmt"
 	"time"
 
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
@@ -11,11 +10,9 @@ import (
 )
 
 // Remove user permissions when they leave
-func OnMemberUpdate(worker *worker.Context, e events.GuildMemberUpdate) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*3) // TODO: Propagate context
+func OnMemberUpdate(worker *worker.Context, e events.GuildMemberUpdate) error {
+	ctx, cancel := context.WithTimeout(worker.BaseContext(), time.Second*3)
 	defer cancel()
 
-	if err := utils.ToRetriever(worker).Cache().DeleteCachedPermissionLevel(ctx, e.GuildId, e.User.Id); err != nil {
-		fmt.Print(err)
-	}
+	return utils.ToRetriever(worker).Cache().DeleteCachedPermissionLevel(ctx, e.GuildId, e.User.Id)
 }
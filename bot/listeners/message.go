@@ -11,6 +11,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Utilities/model"
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/statsd"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
@@ -19,26 +20,31 @@ import (
 )
 
 // proxy messages to web UI + set last message id
-func OnMessage(worker *worker.Context, e events.MessageCreate) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*7) // TODO: Propagate context
+func OnMessage(worker *worker.Context, e events.MessageCreate) error {
+	ctx, cancel := context.WithTimeout(worker.BaseContext(), time.Second*7)
 	defer cancel()
 
 	statsd.Client.IncrementKey(statsd.KeyMessages)
 
 	// ignore DMs
 	if e.GuildId == 0 {
-		return
+		return nil
 	}
 
 	ticket, isTicket, err := getTicket(ctx, e.ChannelId)
 	if err != nil {
-		fmt.Print(err, utils.MessageCreateErrorContext(e))
-		return
+		return err
 	}
 
 	// ensure valid ticket channel
 	if !isTicket || ticket.Id == 0 {
-		return
+		return nil
+	}
+
+	// Tickets on hold keep their status, and are not timed, until they are resumed
+	onHold, err := logic.IsOnHold(ctx, ticket)
+	if err != nil {
+		return err
 	}
 
 	var isStaffCached *bool
@@ -47,25 +53,30 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 	if e.Author.Id != worker.BotId && !e.Author.Bot {
 		// set participants, for logging
 		if err := dbclient.Client.Participants.Set(ctx, e.GuildId, ticket.Id, e.Author.Id); err != nil {
-			fmt.Print(err, utils.MessageCreateErrorContext(e))
+			return err
 		}
 
 		isStaffCached, err := isStaff(ctx, e, ticket)
-
 		if err != nil {
-			fmt.Print(err, utils.MessageCreateErrorContext(e))
-		} else {
-			// set ticket last message, for autoclose
-			// isStaffCached cannot be nil at this point
-			if err := updateLastMessage(ctx, e, ticket, isStaffCached); err != nil {
-				fmt.Print(err, utils.MessageCreateErrorContext(e))
+			return err
+		}
+
+		// set ticket last message, for autoclose
+		// isStaffCached cannot be nil at this point
+		if err := updateLastMessage(ctx, e, ticket, isStaffCached); err != nil {
+			return err
+		}
+
+		if isStaffCached { // check the user is staff
+			// We don't have to check for previous responses due to ON CONFLICT DO NOTHING
+			if err := dbclient.Client.FirstResponseTime.Set(ctx, e.GuildId, e.Author.Id, ticket.Id, time.Now().Sub(ticket.OpenTime)); err != nil {
+				return err
 			}
+		}
 
-			if isStaffCached { // check the user is staff
-				// We don't have to check for previous responses due to ON CONFLICT DO NOTHING
-				if err := dbclient.Client.FirstResponseTime.Set(ctx, e.GuildId, e.Author.Id, ticket.Id, time.Now().Sub(ticket.OpenTime)); err != nil {
-					fmt.Print(err, utils.MessageCreateErrorContext(e))
-				}
+		if !onHold {
+			if err := updateSlaTimers(ctx, ticket, isStaffCached); err != nil {
+				return err
 			}
 		}
 	}
@@ -78,15 +89,14 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 	}
 
 	// Ignore the welcome message and ping message
-	if e.Author.Id != worker.BotId {
+	if e.Author.Id != worker.BotId && !onHold {
 		var userIsStaff bool
 		if isStaffCached != nil {
 			userIsStaff = *isStaffCached
 		} else {
 			tmp, err := isStaff(ctx, e, ticket)
 			if err != nil {
-				fmt.Print(err, utils.MessageCreateErrorContext(e))
-				return
+				return err
 			}
 
 			userIsStaff = tmp
@@ -101,16 +111,18 @@ func OnMessage(worker *worker.Context, e events.MessageCreate) {
 
 		if ticket.Status != newStatus {
 			if err := dbclient.Client.Tickets.SetStatus(ctx, e.GuildId, ticket.Id, newStatus); err != nil {
-				fmt.Print(err, utils.MessageCreateErrorContext(e))
+				return err
 			}
 
 			if !ticket.IsThread {
 				if err := dbclient.Client.CategoryUpdateQueue.Add(ctx, e.GuildId, ticket.Id, newStatus); err != nil {
-					fmt.Print(err, utils.MessageCreateErrorContext(e))
+					return err
 				}
 			}
 		}
 	}
+
+	return nil
 }
 
 func updateLastMessage(ctx context.Context, msg events.MessageCreate, ticket database.Ticket, isStaff bool) error {
@@ -138,6 +150,20 @@ func updateLastMessage(ctx context.Context, msg events.MessageCreate, ticket dat
 	return dbclient.Client.TicketLastMessage.Set(ctx, ticket.GuildId, ticket.Id, msg.Id, msg.Author.Id, isStaff)
 }
 
+func updateSlaTimers(ctx context.Context, ticket database.Ticket, isStaff bool) error {
+	if isStaff {
+		return logic.StopSlaTimers(ctx, ticket)
+	}
+
+	// Only the first message since staff last responded starts the timer, so that the deadline is counted from the
+	// message that has been waiting longest
+	if ticket.Status != model.TicketStatusPending {
+		return nil
+	}
+
+	return logic.StartSlaTimer(ctx, ticket, database.SlaBreachTypeNextResponse, time.Now())
+}
+
 // This method should not be used for anything requiring elevated privileges
 func isStaff(ctx context.Context, msg events.MessageCreate, ticket database.Ticket) (bool, error) {
 	// If the user is the ticket opener, they are not staff
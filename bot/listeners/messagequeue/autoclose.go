@@ -17,15 +17,28 @@ import (
 
 const AutoCloseReason = "Automatically closed due to inactivity"
 
-func ListenAutoClose() {
+func ListenAutoClose(ctx context.Context) {
 	ch := make(chan autoclose.Ticket)
-	go autoclose.Listen(redis.Client, ch)
+	poller := redis.NewPollerClient()
+	go autoclose.Listen(poller, ch)
+
+	// Count the listener itself as in flight, so that WaitForInFlight cannot return between it receiving work and
+	// registering it
+	inFlight.Add(1)
+	defer inFlight.Done()
+
+	for {
+		ticket, ok := receive(ctx, ch, poller)
+		if !ok {
+			// Stop taking new work while shutting down
+			return
+		}
 
-	for ticket := range ch {
 		statsd.Client.IncrementKey(statsd.AutoClose)
 
-		ticket := ticket
 		go func() {
+			defer inFlight.Done()
+
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
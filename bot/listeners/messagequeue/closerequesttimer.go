@@ -15,15 +15,28 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 )
 
-func ListenCloseRequestTimer() {
+func ListenCloseRequestTimer(ctx context.Context) {
 	ch := make(chan database.CloseRequest)
-	go closerequest.Listen(redis.Client, ch)
+	poller := redis.NewPollerClient()
+	go closerequest.Listen(poller, ch)
+
+	// Count the listener itself as in flight, so that WaitForInFlight cannot return between it receiving work and
+	// registering it
+	inFlight.Add(1)
+	defer inFlight.Done()
+
+	for {
+		request, ok := receive(ctx, ch, poller)
+		if !ok {
+			// Stop taking new work while shutting down
+			return
+		}
 
-	for request := range ch {
 		statsd.Client.IncrementKey(statsd.AutoClose)
 
-		request := request
 		go func() {
+			defer inFlight.Done()
+
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
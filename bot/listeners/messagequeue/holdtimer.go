@@ -0,0 +1,105 @@
+package messagequeue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/cache"
+	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+)
+
+const (
+	holdTimerInterval  = time.Second * 30
+	holdTimerBatchSize = 100
+	holdResumeTimeout  = time.Second * 15
+)
+
+// ListenHoldTimers periodically takes the tickets whose hold has expired, from any worker, and resumes them
+func ListenHoldTimers(ctx context.Context) {
+	ticker := time.NewTicker(holdTimerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Stop taking new work while shutting down
+			return
+		case <-ticker.C:
+		}
+
+		timers, lease, err := redis.TakeDueHoldTimers(ctx, time.Now(), holdTimerBatchSize)
+		if err != nil {
+			fmt.Print(err)
+		}
+
+		for _, timer := range timers {
+			timer := timer
+
+			inFlight.Add(1)
+			go func() {
+				defer inFlight.Done()
+
+				ctx, cancel := context.WithTimeout(context.Background(), holdResumeTimeout)
+				defer cancel()
+
+				// Timers that fail are left leased, so that they are taken again once the lease ends
+				if err := handleHoldTimer(ctx, timer); err != nil {
+					fmt.Print(err)
+					return
+				}
+
+				if err := redis.CompleteHoldTimer(ctx, timer, lease); err != nil {
+					fmt.Print(err)
+				}
+			}()
+		}
+	}
+}
+
+func handleHoldTimer(ctx context.Context, timer redis.HoldTimer) error {
+	// get ticket
+	ticket, err := dbclient.Client.Tickets.Get(ctx, timer.TicketId, timer.GuildId)
+	if err != nil {
+		return err
+	}
+
+	if ticket.Id == 0 || !ticket.Open || ticket.ChannelId == nil {
+		return nil
+	}
+
+	// The ticket may have been taken off hold, or held again without a time limit, since the timer was taken
+	hold, ok, err := dbclient.Client.TicketHolds.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	if !ok || hold.Until == nil || hold.Until.After(time.Now()) {
+		return nil
+	}
+
+	if _, err := logic.ResumeTicket(ctx, ticket); err != nil {
+		return err
+	}
+
+	// get worker
+	worker, err := buildContext(ctx, ticket, cache.Client)
+	if err != nil {
+		return err
+	}
+
+	// The ticket has been resumed, so failing to announce it is not retried
+	cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, *ticket.ChannelId, worker.BotId)
+	resumedEmbed := utils.BuildEmbed(cc, customisation.Green, i18n.TitleResumed, i18n.MessageHoldAutoResumed, nil)
+	if _, err := worker.CreateMessageEmbed(*ticket.ChannelId, resumedEmbed); err != nil {
+		fmt.Print(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,54 @@
+package messagequeue
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+)
+
+// pollerStopTimeout is how long to wait for a value that a poller popped before it was stopped
+const pollerStopTimeout = time.Second
+
+// inFlight tracks work received from the message queues that has not yet completed
+var inFlight sync.WaitGroup
+
+// WaitForInFlight waits for any work already received from the message queues to complete. The listeners should be
+// stopped first by cancelling their context, so that no new work is started. Returns false if the timeout is reached.
+func WaitForInFlight(timeout time.Duration) bool {
+	return utils.WaitTimeout(&inFlight, timeout)
+}
+
+// receive waits for the next value popped from a queue by its poller, until ctx is cancelled. The poller must use its
+// own client, see redis.NewPollerClient, which is closed once ctx is cancelled so that no more work is popped. A value
+// that was popped before then has been removed from the queue, so it is still returned rather than dropped. Each value
+// returned is registered with inFlight, and the caller must call inFlight.Done once it has been handled.
+func receive[T any](ctx context.Context, ch <-chan T, poller *goredis.Client) (T, bool) {
+	select {
+	case value, ok := <-ch:
+		if ok {
+			inFlight.Add(1)
+		}
+
+		return value, ok
+	case <-ctx.Done():
+	}
+
+	// Closing the client fails any pop still waiting for work, but a pop that has completed may not have handed its
+	// value over yet. The client may already be closed by an earlier call.
+	_ = poller.Close()
+
+	select {
+	case value, ok := <-ch:
+		if ok {
+			inFlight.Add(1)
+		}
+
+		return value, ok
+	case <-time.After(pollerStopTimeout):
+		var zero T
+		return zero, false
+	}
+}
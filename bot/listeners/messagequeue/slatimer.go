@@ -0,0 +1,82 @@
+package messagequeue
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/cache"
+	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+)
+
+const (
+	slaTimerInterval  = time.Second * 30
+	slaTimerBatchSize = 100
+	slaBreachTimeout  = time.Second * 15
+)
+
+// ListenSlaTimers periodically takes the SLA timers that have expired, from any worker, and handles the breaches
+func ListenSlaTimers(ctx context.Context) {
+	ticker := time.NewTicker(slaTimerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			// Stop taking new work while shutting down
+			return
+		case <-ticker.C:
+		}
+
+		timers, lease, err := redis.TakeDueSlaTimers(ctx, time.Now(), slaTimerBatchSize)
+		if err != nil {
+			fmt.Print(err)
+		}
+
+		for _, timer := range timers {
+			timer := timer
+
+			inFlight.Add(1)
+			go func() {
+				defer inFlight.Done()
+
+				ctx, cancel := context.WithTimeout(context.Background(), slaBreachTimeout)
+				defer cancel()
+
+				// Timers that fail are left leased, so that they are taken again once the lease ends
+				if err := handleSlaTimer(ctx, timer); err != nil {
+					fmt.Print(err)
+					return
+				}
+
+				if err := redis.CompleteSlaTimer(ctx, timer, lease); err != nil {
+					fmt.Print(err)
+				}
+			}()
+		}
+	}
+}
+
+func handleSlaTimer(ctx context.Context, timer redis.SlaTimer) error {
+	// get ticket
+	ticket, err := dbclient.Client.Tickets.Get(ctx, timer.TicketId, timer.GuildId)
+	if err != nil {
+		return err
+	}
+
+	if ticket.Id == 0 || ticket.ChannelId == nil {
+		return nil
+	}
+
+	// get worker
+	worker, err := buildContext(ctx, ticket, cache.Client)
+	if err != nil {
+		return err
+	}
+
+	cc := cmdcontext.NewAutoCloseContext(ctx, worker, ticket.GuildId, *ticket.ChannelId, worker.BotId)
+	return logic.HandleSlaBreach(ctx, cc, ticket, timer.Type)
+}
@@ -17,14 +17,26 @@ import (
 )
 
 // TODO: Make this good
-func ListenTicketClose() {
+func ListenTicketClose(ctx context.Context) {
 	ch := make(chan closerelay.TicketClose)
-	go closerelay.Listen(redis.Client, ch)
+	poller := redis.NewPollerClient()
+	go closerelay.Listen(poller, ch)
 
-	for payload := range ch {
-		payload := payload
+	// Count the listener itself as in flight, so that WaitForInFlight cannot return between it receiving work and
+	// registering it
+	inFlight.Add(1)
+	defer inFlight.Done()
+
+	for {
+		payload, ok := receive(ctx, ch, poller)
+		if !ok {
+			// Stop taking new work while shutting down
+			return
+		}
 
 		go func() {
+			defer inFlight.Done()
+
 			ctx, cancel := context.WithTimeout(context.Background(), constants.TimeoutCloseTicket)
 			defer cancel()
 
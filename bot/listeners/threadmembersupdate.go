@@ -13,24 +13,22 @@ import (
 	"github.com/rxdn/gdl/gateway/payloads/events"
 )
 
-func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Second*6) // TODO: Propagate context
+func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate) error {
+	ctx, cancel := context.WithTimeout(worker.BaseContext(), time.Second*6)
 	defer cancel()
 
 	settings, err := dbclient.Client.Settings.Get(ctx, e.GuildId)
 	if err != nil {
-		fmt.Print(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	ticket, err := dbclient.Client.Tickets.GetByChannelAndGuild(ctx, e.ThreadId, e.GuildId)
 	if err != nil {
-		fmt.Print(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-		return
+		return err
 	}
 
 	if ticket.Id == 0 || ticket.GuildId != e.GuildId {
-		return
+		return nil
 	}
 
 	if ticket.JoinMessageId != nil {
@@ -38,8 +36,7 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 		if ticket.PanelId != nil {
 			tmp, err := dbclient.Client.Panel.GetById(ctx, *ticket.PanelId)
 			if err != nil {
-				fmt.Print(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-				return
+				return err
 			}
 
 			if tmp.PanelId != 0 && e.GuildId == tmp.GuildId {
@@ -50,7 +47,7 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 		threadStaff, err := logic.GetStaffInThread(ctx, worker, ticket, e.ThreadId)
 		if err != nil {
 			fmt.Print(err, errorcontext.WorkerErrorContext{Guild: e.GuildId})
-			return
+			return nil
 		}
 
 		if settings.TicketNotificationChannel != nil {
@@ -60,4 +57,6 @@ func OnThreadMembersUpdate(worker *worker.Context, e events.ThreadMembersUpdate)
 			}
 		}
 	}
+
+	return nil
 }
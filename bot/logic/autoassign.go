@@ -0,0 +1,205 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"sort"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/rxdn/gdl/cache"
+)
+
+// ChooseAssignee picks the staff member that a new ticket should be assigned to, from the members that are on-call in
+// the panel's support teams. Returns nil if auto-assignment is disabled, or if nobody is available.
+func ChooseAssignee(ctx context.Context, cmd registry.CommandContext, panel *database.Panel, openerId uint64) (*uint64, error) {
+	mode, err := dbclient.Client.AutoAssignSettings.GetMode(ctx, cmd.GuildId())
+	if err != nil {
+		return nil, err
+	}
+
+	if mode == database.AutoAssignModeDisabled {
+		return nil, nil
+	}
+
+	candidates, err := getAssignmentCandidates(ctx, cmd, panel, openerId)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	var turn int64
+	var openClaims map[uint64]int
+	switch mode {
+	case database.AutoAssignModeRoundRobin:
+		turn, err = redis.NextAutoAssignTurn(ctx, cmd.GuildId())
+	case database.AutoAssignModeLeastClaims:
+		openClaims, err = dbclient.Client.TicketClaims.GetOpenClaimCounts(ctx, cmd.GuildId())
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	assignee, ok := selectAssignee(mode, candidates, turn, openClaims, rand.Intn)
+	if !ok {
+		return nil, nil
+	}
+
+	return &assignee, nil
+}
+
+// AssignTicket claims the ticket for the assignee. Threads cannot be claimed with ClaimTicket, as their permissions
+// cannot be changed, so the claim is recorded and the assignee is added to the thread instead. If the ticket cannot be
+// claimed, the claim is removed, so that staff can claim the ticket themselves.
+func AssignTicket(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, assigneeId uint64) error {
+	var err error
+	if ticket.IsThread {
+		err = assignThread(ctx, cmd, ticket, assigneeId)
+	} else {
+		err = ClaimTicket(ctx, cmd, ticket, assigneeId)
+	}
+
+	if err != nil {
+		if deleteErr := dbclient.Client.TicketClaims.Delete(ctx, ticket.GuildId, ticket.Id); deleteErr != nil {
+			return errors.Join(err, deleteErr)
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func assignThread(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, assigneeId uint64) error {
+	if ticket.ChannelId == nil {
+		return errors.New("channel ID is nil")
+	}
+
+	if err := dbclient.Client.TicketClaims.Set(ctx, ticket.GuildId, ticket.Id, assigneeId); err != nil {
+		return err
+	}
+
+	return cmd.Worker().AddThreadMember(*ticket.ChannelId, assigneeId)
+}
+
+// getAssignmentCandidates returns the on-call members that are in the panel's support teams. This runs while a ticket
+// is being opened, so the teams are fetched once, and members are only looked up in the cache: members whose roles
+// would have to be fetched from Discord are not assigned tickets.
+func getAssignmentCandidates(ctx context.Context, cmd registry.CommandContext, panel *database.Panel, openerId uint64) ([]uint64, error) {
+	onCall, err := dbclient.Client.OnCall.GetUsers(ctx, cmd.GuildId())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(onCall) == 0 {
+		return nil, nil
+	}
+
+	var teamUsers, teamRoles []uint64
+	if panel == nil || panel.WithDefaultTeam {
+		teamUsers, err = dbclient.Client.Permissions.GetSupport(ctx, cmd.GuildId())
+		if err != nil {
+			return nil, err
+		}
+
+		teamRoles, err = dbclient.Client.RolePermissions.GetSupportRoles(ctx, cmd.GuildId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if panel != nil {
+		panelUsers, err := dbclient.Client.SupportTeamMembers.GetAllSupportMembersForPanel(ctx, panel.PanelId)
+		if err != nil {
+			return nil, err
+		}
+
+		panelRoles, err := dbclient.Client.SupportTeamRoles.GetAllSupportRolesForPanel(ctx, panel.PanelId)
+		if err != nil {
+			return nil, err
+		}
+
+		teamUsers = append(teamUsers, panelUsers...)
+		teamRoles = append(teamRoles, panelRoles...)
+	}
+
+	candidates := make([]uint64, 0, len(onCall))
+	for _, userId := range onCall {
+		// Staff may open tickets for themselves
+		if userId == openerId {
+			continue
+		}
+
+		if utils.Contains(teamUsers, userId) {
+			candidates = append(candidates, userId)
+			continue
+		}
+
+		if len(teamRoles) == 0 {
+			continue
+		}
+
+		member, err := cmd.Worker().Cache.GetMember(ctx, cmd.GuildId(), userId)
+		if err != nil {
+			// The member is not cached, or has left the server
+			if errors.Is(err, cache.ErrNotFound) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if utils.HasIntersection(teamRoles, member.Roles) {
+			candidates = append(candidates, userId)
+		}
+	}
+
+	return candidates, nil
+}
+
+// selectAssignee picks a member from candidates using the given mode. turn is only used for round-robin, and
+// openClaims for least-open-claims. Ties are broken by the lowest user ID, so that the choice is stable.
+func selectAssignee(
+	mode database.AutoAssignMode,
+	candidates []uint64,
+	turn int64,
+	openClaims map[uint64]int,
+	randIntn func(int) int,
+) (uint64, bool) {
+	if len(candidates) == 0 {
+		return 0, false
+	}
+
+	sorted := make([]uint64, len(candidates))
+	copy(sorted, candidates)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	switch mode {
+	case database.AutoAssignModeRoundRobin:
+		// Turns start at 1, and wrap around the candidates
+		return sorted[(turn-1)%int64(len(sorted))], true
+	case database.AutoAssignModeLeastClaims:
+		assignee := sorted[0]
+		for _, userId := range sorted[1:] {
+			if openClaims[userId] < openClaims[assignee] {
+				assignee = userId
+			}
+		}
+
+		return assignee, true
+	case database.AutoAssignModeRandom:
+		return sorted[randIntn(len(sorted))], true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"testing"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectAssignee(t *testing.T) {
+	candidates := []uint64{30, 10, 20}
+	noRand := func(int) int {
+		t.Fatal("random should not be used")
+		return 0
+	}
+
+	// Round-robin takes the members in order of user ID, wrapping around
+	assignee, ok := selectAssignee(database.AutoAssignModeRoundRobin, candidates, 2, nil, noRand)
+	require.True(t, ok)
+	require.Equal(t, uint64(20), assignee)
+
+	assignee, _ = selectAssignee(database.AutoAssignModeRoundRobin, candidates, 4, nil, noRand)
+	require.Equal(t, uint64(10), assignee)
+
+	// Members with no open claims are missing from the map
+	assignee, _ = selectAssignee(database.AutoAssignModeLeastClaims, candidates, 0, map[uint64]int{10: 2, 30: 1}, noRand)
+	require.Equal(t, uint64(20), assignee)
+
+	assignee, _ = selectAssignee(database.AutoAssignModeRandom, candidates, 0, nil, func(n int) int {
+		require.Equal(t, 3, n)
+		return 2
+	})
+	require.Equal(t, uint64(30), assignee)
+
+	_, ok = selectAssignee(database.AutoAssignModeRandom, nil, 0, nil, noRand)
+	require.False(t, ok)
+}
@@ -54,7 +54,12 @@ func ClaimTicket(ctx context.Context, cmd registry.CommandContext, ticket databa
 
 	// If newOverwrites = nil, no changes to permissions should be made
 	if newOverwrites != nil {
-		channelName, err := GenerateChannelName(ctx, cmd, panel, ticket.Id, ticket.UserId, &userId)
+		priority, err := GetTicketPriority(ctx, ticket)
+		if err != nil {
+			return err
+		}
+
+		channelName, err := GenerateChannelName(ctx, cmd, panel, ticket.Id, ticket.UserId, &userId, priority)
 		if err != nil {
 			return err
 		}
@@ -223,6 +223,15 @@ func CloseTicket(ctx context.Context, cmd registry.CommandContext, reason *strin
 		fmt.Print(err, cmd.ToErrorContext())
 	}
 
+	// A closed ticket is no longer on hold, so it must not be resumed by its hold timer
+	if err := dbclient.Client.TicketHolds.Delete(ctx, ticket.GuildId, ticket.Id); err != nil {
+		fmt.Print(err, cmd.ToErrorContext())
+	}
+
+	if err := redis.CancelHoldTimer(ctx, redis.HoldTimer{GuildId: ticket.GuildId, TicketId: ticket.Id}); err != nil {
+		fmt.Print(err, cmd.ToErrorContext())
+	}
+
 	// Delete join thread button
 	if ticket.IsThread && ticket.JoinMessageId != nil && settings.TicketNotificationChannel != nil {
 		_ = cmd.Worker().DeleteMessage(*settings.TicketNotificationChannel, *ticket.JoinMessageId)
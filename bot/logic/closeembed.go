@@ -25,6 +25,11 @@ func NoopElement() CloseEmbedElement {
 	}
 }
 
+// TranscriptUrl returns the link to view the transcript of the ticket on the dashboard
+func TranscriptUrl(guildId uint64, ticketId int) string {
+	return fmt.Sprintf("https://dashboard.ticketsbot.net/manage/%d/transcripts/view/%d", guildId, ticketId)
+}
+
 func TranscriptLinkElement(condition bool) CloseEmbedElement {
 	if !condition {
 		return NoopElement()
@@ -36,7 +41,7 @@ func TranscriptLinkElement(condition bool) CloseEmbedElement {
 			transcriptEmoji = customisation.EmojiTranscript.BuildEmoji()
 		}
 
-		transcriptLink := fmt.Sprintf("https://dashboard.ticketsbot.net/manage/%d/transcripts/view/%d", ticket.GuildId, ticket.Id)
+		transcriptLink := TranscriptUrl(ticket.GuildId, ticket.Id)
 
 		return utils.Slice(component.BuildButton(component.Button{
 			Label: "View Online Transcript",
@@ -179,6 +184,14 @@ func BuildCloseEmbed(
 
 	closeEmbed = closeEmbed.AddField(formatTitle("Reason", customisation.EmojiReason, worker.IsWhitelabel), formattedReason, false)
 
+	// Tickets closed by /merge link to the ticket they were merged into
+	mergedInto, merged, err := dbclient.Client.TicketMerges.GetMergedInto(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		fmt.Print(err)
+	} else if merged {
+		closeEmbed = closeEmbed.AddField(formatTitle("Merged Into", customisation.EmojiId, worker.IsWhitelabel), strconv.Itoa(mergedInto), false)
+	}
+
 	var rows []component.Component
 	for _, row := range components {
 		var rowElements []component.Component
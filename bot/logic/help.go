@@ -0,0 +1,216 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/commandpermission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/guild/emoji"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/interaction/component"
+)
+
+type helpCategory struct {
+	category command.Category
+	lines    []string
+}
+
+// ShownInHelp returns true if the command should be listed by /help for the user, before the guild's permission
+// overrides are taken into account
+func ShownInHelp(properties registry.Properties, userId uint64) bool {
+	// check bot admin / helper only commands
+	if (properties.AdminOnly && !utils.IsBotAdmin(userId)) || (properties.HelperOnly && !utils.IsBotHelper(userId)) {
+		return false
+	}
+
+	// Show slash commands only
+	return properties.Type == interaction.ApplicationCommandTypeChatInput
+}
+
+// CommandPathChoices returns autocomplete choices for the paths of the commands shown in /help, such as
+// "managetags add", that contain the value typed so far
+func CommandPathChoices(
+	commands registry.Registry,
+	data interaction.ApplicationCommandAutoCompleteInteraction,
+	value string,
+) []interaction.ApplicationCommandOptionChoice {
+	value = strings.ToLower(strings.TrimPrefix(strings.TrimSpace(value), "/"))
+
+	var userId uint64
+	if data.Member != nil {
+		userId = data.Member.User.Id
+	} else if data.User != nil {
+		userId = data.User.Id
+	}
+
+	var paths []string
+	for _, cmd := range commands {
+		properties := cmd.Properties()
+		if !ShownInHelp(properties, userId) {
+			continue
+		}
+
+		paths = append(paths, properties.Name)
+
+		for _, child := range properties.Children {
+			if ShownInHelp(child.Properties(), userId) {
+				paths = append(paths, properties.Name+" "+child.Properties().Name)
+			}
+		}
+	}
+
+	sort.Strings(paths)
+
+	choices := make([]interaction.ApplicationCommandOptionChoice, 0, 25)
+	for _, path := range paths {
+		if !strings.Contains(path, value) {
+			continue
+		}
+
+		choices = append(choices, utils.StringChoice(path))
+		if len(choices) == 25 {
+			break
+		}
+	}
+
+	return choices
+}
+
+// BuildHelpPage builds a page of the command list, with one page per category of commands that the user can run.
+// The page is clamped to the range of pages that exist.
+func BuildHelpPage(ctx context.Context, cmd registry.CommandContext, commands registry.Registry, page int) (*embed.Embed, []component.Component, error) {
+	categories, err := buildHelpCategories(ctx, cmd, commands)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if page >= len(categories) {
+		page = len(categories) - 1
+	}
+
+	if page < 0 {
+		page = 0
+	}
+
+	msgEmbed := embed.NewEmbed().
+		SetColor(cmd.GetColour(customisation.Green)).
+		SetTitle(cmd.GetMessage(i18n.TitleHelp)).
+		SetFooter(fmt.Sprintf("Tickets by jaDevelopment • Page %d/%d", page+1, max(len(categories), 1)), "https://avatars.githubusercontent.com/u/142818403")
+
+	if len(categories) > 0 {
+		category := categories[page]
+		msgEmbed.SetDescription(utils.StringMax(fmt.Sprintf("**%s**\n%s", category.category, strings.Join(category.lines, "\n")), 4096))
+	}
+
+	components := []component.Component{
+		component.BuildActionRow(
+			component.BuildButton(component.Button{
+				CustomId: fmt.Sprintf("help_%d", page-1),
+				Style:    component.ButtonStylePrimary,
+				Emoji: &emoji.Emoji{
+					Name: "◀️",
+				},
+				Disabled: page <= 0,
+			}),
+			component.BuildButton(component.Button{
+				CustomId: fmt.Sprintf("help_%d", page+1),
+				Style:    component.ButtonStylePrimary,
+				Emoji: &emoji.Emoji{
+					Name: "▶️",
+				},
+				Disabled: page >= len(categories)-1,
+			}),
+		),
+	}
+
+	return msgEmbed, components, nil
+}
+
+// buildHelpCategories returns the formatted commands that the user can run in each category, in the order of
+// command.Categories. Categories without any such commands are omitted.
+func buildHelpCategories(ctx context.Context, cmd registry.CommandContext, commands registry.Registry) ([]helpCategory, error) {
+	permLevel, err := cmd.UserPermissionLevel(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	member, err := cmd.Member()
+	if err != nil {
+		return nil, err
+	}
+
+	overrides, err := commandpermission.Get(ctx, cmd.GuildId())
+	if err != nil {
+		return nil, err
+	}
+
+	commandIds, err := command.LoadCommandIds(cmd.Worker(), cmd.Worker().BotId)
+	if err != nil {
+		return nil, err
+	}
+
+	byCategory := make(map[command.Category][]registry.Command)
+	requiredLevels := make(map[string]permission.PermissionLevel)
+
+	for _, c := range commands {
+		properties := c.Properties()
+		if !ShownInHelp(properties, cmd.UserId()) {
+			continue
+		}
+
+		requirement := overrides.Requirement([]string{properties.Name}, properties.PermissionLevel)
+		requiredLevels[properties.Name] = requirement.Level
+
+		if requirement.IsMet(permLevel, member) { // only send commands the user has permissions for
+			byCategory[properties.Category] = append(byCategory[properties.Category], c)
+		}
+	}
+
+	var categories []helpCategory
+	for _, category := range command.Categories {
+		categoryCommands := byCategory[category]
+		if len(categoryCommands) == 0 {
+			continue
+		}
+
+		sort.Slice(categoryCommands, func(i, j int) bool {
+			return categoryCommands[i].Properties().Name < categoryCommands[j].Properties().Name
+		})
+
+		lines := make([]string, 0, len(categoryCommands))
+		for _, c := range categoryCommands {
+			var commandId *uint64
+			if tmp, ok := commandIds[c.Properties().Name]; ok {
+				commandId = &tmp
+			}
+
+			line := registry.FormatHelp(c, cmd.GuildId(), commandId)
+
+			// Show the level after the guild's overrides, as it may differ from the documented level
+			switch requiredLevels[c.Properties().Name] {
+			case permission.Support:
+				line += " " + cmd.GetMessage(i18n.MessageHelpSupportOnly)
+			case permission.Admin:
+				line += " " + cmd.GetMessage(i18n.MessageHelpAdminOnly)
+			}
+
+			lines = append(lines, line)
+		}
+
+		categories = append(categories, helpCategory{
+			category: category,
+			lines:    lines,
+		})
+	}
+
+	return categories, nil
+}
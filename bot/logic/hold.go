@@ -0,0 +1,113 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/model"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+)
+
+// HoldTicket puts the ticket on hold, while it is waiting on a third party. The status is not changed by new messages,
+// the ticket is excluded from autoclose and its SLA timers are stopped until it is resumed, either with ResumeTicket or
+// automatically at until, if given.
+func HoldTicket(ctx context.Context, ticket database.Ticket, userId uint64, reason *string, until *time.Time) error {
+	alreadyHeld, err := dbclient.Client.TicketHolds.Hold(ctx, database.TicketHold{
+		GuildId:  ticket.GuildId,
+		TicketId: ticket.Id,
+		UserId:   userId,
+		Reason:   reason,
+		Until:    until,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Timers are only changed once the hold has been committed
+	if err := StopSlaTimers(ctx, ticket); err != nil {
+		return err
+	}
+
+	timer := redis.HoldTimer{GuildId: ticket.GuildId, TicketId: ticket.Id}
+	if until == nil {
+		if err := redis.CancelHoldTimer(ctx, timer); err != nil {
+			return err
+		}
+	} else {
+		if err := redis.ScheduleHoldTimer(ctx, timer, *until); err != nil {
+			return err
+		}
+	}
+
+	if alreadyHeld || ticket.IsThread {
+		return nil
+	}
+
+	settings, err := dbclient.Client.HoldSettings.Get(ctx, ticket.GuildId)
+	if err != nil {
+		return err
+	}
+
+	// The status category machinery moves tickets on hold into the on-hold category
+	if settings.OnHoldCategoryId != nil {
+		if err := dbclient.Client.CategoryUpdateQueue.Add(ctx, ticket.GuildId, ticket.Id, ticket.Status); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ResumeTicket takes the ticket off hold. The status is restored from whoever sent the last message, as OnMessage
+// would have set it, and the SLA timer is restarted if the opener is waiting on staff. Returns false if the ticket
+// was not on hold.
+func ResumeTicket(ctx context.Context, ticket database.Ticket) (bool, error) {
+	_, ok, err := dbclient.Client.TicketHolds.Release(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return false, err
+	}
+
+	if !ok {
+		return false, nil
+	}
+
+	if err := redis.CancelHoldTimer(ctx, redis.HoldTimer{GuildId: ticket.GuildId, TicketId: ticket.Id}); err != nil {
+		return false, err
+	}
+
+	lastMessage, err := dbclient.Client.TicketLastMessage.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return false, err
+	}
+
+	status := model.TicketStatusOpen
+	if lastMessage.UserIsStaff != nil && *lastMessage.UserIsStaff {
+		status = model.TicketStatusPending
+	}
+
+	if err := dbclient.Client.Tickets.SetStatus(ctx, ticket.GuildId, ticket.Id, status); err != nil {
+		return false, err
+	}
+
+	if !ticket.IsThread {
+		if err := dbclient.Client.CategoryUpdateQueue.Add(ctx, ticket.GuildId, ticket.Id, status); err != nil {
+			return false, err
+		}
+	}
+
+	// The time spent on hold does not count towards the SLA
+	if status == model.TicketStatusOpen {
+		if err := StartSlaTimer(ctx, ticket, database.SlaBreachTypeNextResponse, time.Now()); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+func IsOnHold(ctx context.Context, ticket database.Ticket) (bool, error) {
+	_, ok, err := dbclient.Client.TicketHolds.Get(ctx, ticket.GuildId, ticket.Id)
+	return ok, err
+}
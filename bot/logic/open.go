@@ -152,9 +152,25 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		}
 	}
 
+	priority, err := PanelPriority(ctx, panel)
+	if err != nil {
+		cmd.HandleError(err)
+		return database.Ticket{}, err
+	}
+
+	prioritySettings, err := dbclient.Client.PrioritySettings.Get(ctx, cmd.GuildId())
+	if err != nil {
+		cmd.HandleError(err)
+		return database.Ticket{}, err
+	}
+
 	// If we're using a panel, then we need to create the ticket in the specified category
+	// High priority tickets are created in the high priority category, rather than being moved there later
 	var category uint64
-	if panel != nil && panel.TargetCategory != 0 {
+	priorityCategory, usingPriorityCategory := PriorityCategory(prioritySettings, priority)
+	if usingPriorityCategory {
+		category = priorityCategory
+	} else if panel != nil && panel.TargetCategory != 0 {
 		category = panel.TargetCategory
 	} else { // else we can just use the default category
 		var err error
@@ -173,7 +189,7 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 			useCategory = false
 
 			if restError, ok := err.(request.RestError); ok && restError.StatusCode == 404 {
-				if panel == nil {
+				if panel == nil && !usingPriorityCategory {
 					if err := dbclient.Client.ChannelCategory.Delete(ctx, cmd.GuildId()); err != nil {
 						cmd.HandleError(err)
 					}
@@ -231,7 +247,14 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		return database.Ticket{}, err
 	}
 
-	name, err := GenerateChannelName(ctx, cmd, panel, ticketId, cmd.UserId(), nil)
+	if priority != database.TicketPriorityNormal {
+		if err := dbclient.Client.TicketPriorities.Set(ctx, cmd.GuildId(), ticketId, priority); err != nil {
+			cmd.HandleError(err)
+			return database.Ticket{}, err
+		}
+	}
+
+	name, err := GenerateChannelName(ctx, cmd, panel, ticketId, cmd.UserId(), nil, priority)
 	if err != nil {
 		cmd.HandleError(err)
 		return database.Ticket{}, err
@@ -353,6 +376,22 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		JoinMessageId:    joinMessageId,
 	}
 
+	// Assign the ticket before the welcome message is sent, so that the assignee is only mentioned in it, in place of
+	// the claim button and the pings, once the ticket has been claimed for them
+	assigneeId, err := ChooseAssignee(ctx, cmd, panel, cmd.UserId())
+	if err != nil {
+		// Fall back to the normal pings, rather than failing to open the ticket
+		cmd.HandleWarning(err)
+		assigneeId = nil
+	}
+
+	if assigneeId != nil {
+		if err := AssignTicket(ctx, cmd, ticket, *assigneeId); err != nil {
+			cmd.HandleWarning(err)
+			assigneeId = nil
+		}
+	}
+
 	// Welcome message
 	group.Go(func() error {
 
@@ -366,7 +405,7 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 			cmd.HandleError(err)
 		}
 
-		welcomeMessageId, err := SendWelcomeMessage(ctx, cmd, ticket, subject, panel, formData, additionalPlaceholders)
+		welcomeMessageId, err := SendWelcomeMessage(ctx, cmd, ticket, subject, panel, formData, additionalPlaceholders, assigneeId)
 		if err != nil {
 			return err
 		}
@@ -389,8 +428,8 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		// mentions
 		var content string
 
-		// Append on-call role pings
-		if isThread {
+		// Append on-call role pings, unless the ticket has been assigned to someone, who is mentioned in the welcome message
+		if isThread && assigneeId == nil {
 			if panel == nil {
 				if metadata.OnCallRole != nil {
 					content += fmt.Sprintf("<@&%d>", *metadata.OnCallRole)
@@ -418,7 +457,7 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 			roles, err := dbclient.Client.PanelRoleMentions.GetRoles(ctx, panel.PanelId)
 			if err != nil {
 				return err
-			} else {
+			} else if assigneeId == nil {
 				for _, roleId := range roles {
 					if roleId == cmd.GuildId() {
 						content += "@everyone"
@@ -439,6 +478,10 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 			}
 		}
 
+		if priority == database.TicketPriorityUrgent && prioritySettings.UrgentPingRole != nil {
+			content += fmt.Sprintf("<@&%d>", *prioritySettings.UrgentPingRole)
+		}
+
 		if content != "" {
 			if len(content) > 2000 {
 				content = content[:2000]
@@ -466,6 +509,11 @@ func OpenTicket(ctx context.Context, cmd registry.InteractionContext, panel *dat
 		return nil
 	})
 
+	// Start the first response SLA timer
+	group.Go(func() error {
+		return StartSlaTimer(ctx, ticket, database.SlaBreachTypeFirstResponse, ticket.OpenTime)
+	})
+
 	// Create webhook
 	// TODO: Create webhook on use, rather than on ticket creation.
 	// TODO: Webhooks for threads should be created on the parent channel.
@@ -834,7 +882,15 @@ func GetIntegrationRoleId(rootCtx context.Context, worker *worker.Context, guild
 	return nil, nil
 }
 
-func GenerateChannelName(ctx context.Context, cmd registry.CommandContext, panel *database.Panel, ticketId int, openerId uint64, claimer *uint64) (string, error) {
+func GenerateChannelName(
+	ctx context.Context,
+	cmd registry.CommandContext,
+	panel *database.Panel,
+	ticketId int,
+	openerId uint64,
+	claimer *uint64,
+	priority database.TicketPriority,
+) (string, error) {
 	// Create ticket name
 	var name string
 
@@ -901,6 +957,18 @@ func GenerateChannelName(ctx context.Context, cmd registry.CommandContext, panel
 		}
 	}
 
+	// Normal priority tickets are not prefixed, so that existing channel names are unchanged
+	if priority = NormalisePriority(priority); priority != database.TicketPriorityNormal {
+		settings, err := dbclient.Client.PrioritySettings.Get(ctx, cmd.GuildId())
+		if err != nil {
+			return "", err
+		}
+
+		if settings.ChannelPrefix {
+			name = fmt.Sprintf("%s-%s", strings.ToLower(cmd.GetMessage(PriorityName(priority))), name)
+		}
+	}
+
 	// Cap length after substitutions
 	if len(name) > 100 {
 		name = name[:100]
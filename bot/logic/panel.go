@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+// PanelChoices returns autocomplete choices for the guild's panels that match the value typed so far
+func PanelChoices(ctx context.Context, guildId uint64, value string) ([]interaction.ApplicationCommandOptionChoice, error) {
+	panels, err := dbclient.Client.Panel.GetByGuild(ctx, guildId)
+	if err != nil {
+		return nil, err
+	}
+
+	if value == "" {
+		if len(panels) > 25 {
+			return panelsToChoices(panels[:25]), nil
+		} else {
+			return panelsToChoices(panels), nil
+		}
+	} else {
+		var filtered []database.Panel
+		for _, panel := range panels {
+			// The panel can be found by its title, or by its ID
+			if strings.Contains(strings.ToLower(panel.Title), strings.ToLower(value)) || strings.HasPrefix(strconv.Itoa(panel.PanelId), value) {
+				filtered = append(filtered, panel)
+			}
+
+			if len(filtered) == 25 {
+				break
+			}
+		}
+
+		return panelsToChoices(filtered), nil
+	}
+}
+
+func panelsToChoices(panels []database.Panel) []interaction.ApplicationCommandOptionChoice {
+	choices := make([]interaction.ApplicationCommandOptionChoice, len(panels))
+	for i, panel := range panels {
+		choices[i] = interaction.ApplicationCommandOptionChoice{
+			Name:  panel.Title,
+			Value: panel.PanelId,
+		}
+	}
+
+	return choices
+}
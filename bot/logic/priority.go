@@ -0,0 +1,97 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+// Priorities is ordered from lowest to highest
+var Priorities = []database.TicketPriority{
+	database.TicketPriorityLow,
+	database.TicketPriorityNormal,
+	database.TicketPriorityHigh,
+	database.TicketPriorityUrgent,
+}
+
+var priorityNames = map[database.TicketPriority]i18n.MessageId{
+	database.TicketPriorityLow:    i18n.PriorityLow,
+	database.TicketPriorityNormal: i18n.PriorityNormal,
+	database.TicketPriorityHigh:   i18n.PriorityHigh,
+	database.TicketPriorityUrgent: i18n.PriorityUrgent,
+}
+
+// PriorityChoices returns the choices for arguments that take a priority, from lowest to highest
+func PriorityChoices() []interaction.ApplicationCommandOptionChoice {
+	return []interaction.ApplicationCommandOptionChoice{
+		{Name: "Low", Value: string(database.TicketPriorityLow)},
+		{Name: "Normal", Value: string(database.TicketPriorityNormal)},
+		{Name: "High", Value: string(database.TicketPriorityHigh)},
+		{Name: "Urgent", Value: string(database.TicketPriorityUrgent)},
+	}
+}
+
+// ParsePriority returns the priority with the given name, ignoring case
+func ParsePriority(name string) (database.TicketPriority, bool) {
+	priority := database.TicketPriority(strings.ToUpper(strings.TrimSpace(name)))
+	if _, ok := priorityNames[priority]; !ok {
+		return "", false
+	}
+
+	return priority, true
+}
+
+// NormalisePriority returns the normal priority for tickets and panels that have not been given a priority
+func NormalisePriority(priority database.TicketPriority) database.TicketPriority {
+	if _, ok := priorityNames[priority]; !ok {
+		return database.TicketPriorityNormal
+	}
+
+	return priority
+}
+
+func PriorityName(priority database.TicketPriority) i18n.MessageId {
+	return priorityNames[NormalisePriority(priority)]
+}
+
+// IsHighPriority returns whether tickets with the priority should be moved to the high priority category
+func IsHighPriority(priority database.TicketPriority) bool {
+	priority = NormalisePriority(priority)
+	return priority == database.TicketPriorityHigh || priority == database.TicketPriorityUrgent
+}
+
+func GetTicketPriority(ctx context.Context, ticket database.Ticket) (database.TicketPriority, error) {
+	priority, err := dbclient.Client.TicketPriorities.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return "", err
+	}
+
+	return NormalisePriority(priority), nil
+}
+
+// PanelPriority returns the priority given to tickets opened from the panel
+func PanelPriority(ctx context.Context, panel *database.Panel) (database.TicketPriority, error) {
+	if panel == nil {
+		return database.TicketPriorityNormal, nil
+	}
+
+	priority, err := dbclient.Client.PanelPriorities.Get(ctx, panel.PanelId)
+	if err != nil {
+		return "", err
+	}
+
+	return NormalisePriority(priority), nil
+}
+
+// PriorityCategory returns the category that a ticket should be moved to because of its priority, if any
+func PriorityCategory(settings database.PrioritySettings, priority database.TicketPriority) (uint64, bool) {
+	if !IsHighPriority(priority) || settings.HighPriorityCategoryId == nil {
+		return 0, false
+	}
+
+	return *settings.HighPriorityCategoryId, true
+}
@@ -0,0 +1,36 @@
+package logic
+
+import (
+	"testing"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPriorityCategory(t *testing.T) {
+	categoryId := uint64(123)
+	settings := database.PrioritySettings{HighPriorityCategoryId: &categoryId}
+
+	// Tickets that have not been given a priority have no row
+	_, ok := PriorityCategory(settings, "")
+	require.False(t, ok)
+
+	_, ok = PriorityCategory(settings, database.TicketPriorityNormal)
+	require.False(t, ok)
+
+	for _, priority := range []database.TicketPriority{database.TicketPriorityHigh, database.TicketPriorityUrgent} {
+		id, ok := PriorityCategory(settings, priority)
+		require.True(t, ok)
+		require.Equal(t, categoryId, id)
+	}
+
+	_, ok = PriorityCategory(database.PrioritySettings{}, database.TicketPriorityUrgent)
+	require.False(t, ok)
+
+	priority, ok := ParsePriority(" urgent ")
+	require.True(t, ok)
+	require.Equal(t, database.TicketPriorityUrgent, priority)
+
+	_, ok = ParsePriority("critical")
+	require.False(t, ok)
+}
@@ -0,0 +1,126 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/channel/message"
+	"github.com/rxdn/gdl/rest"
+)
+
+// StartSlaTimer starts the timer for the ticket's panel's SLA target, counting from since. Tickets without a panel, or
+// whose panel has no target of the given type, are not timed.
+func StartSlaTimer(ctx context.Context, ticket database.Ticket, breachType database.SlaBreachType, since time.Time) error {
+	if ticket.PanelId == nil {
+		return nil
+	}
+
+	sla, ok, err := dbclient.Client.PanelSlas.Get(ctx, *ticket.PanelId)
+	if err != nil {
+		return err
+	}
+
+	target := slaTarget(sla, breachType)
+	if !ok || target == nil {
+		return nil
+	}
+
+	timer := redis.SlaTimer{
+		GuildId:  ticket.GuildId,
+		TicketId: ticket.Id,
+		Type:     breachType,
+	}
+
+	return redis.ScheduleSlaTimer(ctx, timer, since.Add(*target))
+}
+
+// StopSlaTimers stops the timers of the ticket, as staff have responded
+func StopSlaTimers(ctx context.Context, ticket database.Ticket) error {
+	return redis.CancelSlaTimers(ctx, ticket.GuildId, ticket.Id, database.SlaBreachTypeFirstResponse, database.SlaBreachTypeNextResponse)
+}
+
+// HandleSlaBreach is called when an SLA timer of the ticket expires. The ticket is checked again, as the timer is only
+// stopped on a best-effort basis: if staff sent the last message, the target was met.
+func HandleSlaBreach(ctx context.Context, cmd registry.CommandContext, ticket database.Ticket, breachType database.SlaBreachType) error {
+	if !ticket.Open || ticket.ChannelId == nil || ticket.PanelId == nil {
+		return nil
+	}
+
+	lastMessage, err := dbclient.Client.TicketLastMessage.Get(ctx, ticket.GuildId, ticket.Id)
+	if err != nil {
+		return err
+	}
+
+	if lastMessage.UserIsStaff != nil && *lastMessage.UserIsStaff {
+		return nil
+	}
+
+	sla, ok, err := dbclient.Client.PanelSlas.Get(ctx, *ticket.PanelId)
+	if err != nil {
+		return err
+	}
+
+	// The target may have been removed since the timer was started
+	target := slaTarget(sla, breachType)
+	if !ok || target == nil {
+		return nil
+	}
+
+	escalationRoleId := sla.EscalationRoleId
+	if escalationRoleId == nil {
+		metadata, err := dbclient.Client.GuildMetadata.Get(ctx, ticket.GuildId)
+		if err != nil {
+			return err
+		}
+
+		escalationRoleId = metadata.OnCallRole
+	}
+
+	messageId := i18n.MessageSlaFirstResponseBreached
+	if breachType == database.SlaBreachTypeNextResponse {
+		messageId = i18n.MessageSlaNextResponseBreached
+	}
+
+	data := rest.CreateMessageData{
+		Embeds: []*embed.Embed{utils.BuildEmbed(cmd, customisation.Red, i18n.TitleSlaBreached, messageId, nil, utils.FormatTime(*target))},
+		AllowedMentions: message.AllowedMention{
+			Parse: []message.AllowedMentionType{message.ROLES},
+		},
+	}
+
+	if escalationRoleId != nil {
+		data.Content = fmt.Sprintf("<@&%d>", *escalationRoleId)
+	}
+
+	if _, err := cmd.Worker().CreateMessageComplex(*ticket.ChannelId, data); err != nil {
+		return err
+	}
+
+	return dbclient.Client.SlaBreaches.Create(ctx, database.SlaBreach{
+		GuildId:    ticket.GuildId,
+		TicketId:   ticket.Id,
+		Type:       breachType,
+		Target:     *target,
+		BreachedAt: time.Now(),
+	})
+}
+
+func slaTarget(sla database.PanelSla, breachType database.SlaBreachType) *time.Duration {
+	switch breachType {
+	case database.SlaBreachTypeFirstResponse:
+		return sla.FirstResponse
+	case database.SlaBreachTypeNextResponse:
+		return sla.NextResponse
+	default:
+		return nil
+	}
+}
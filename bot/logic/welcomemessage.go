@@ -19,6 +19,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/channel/embed"
+	"github.com/rxdn/gdl/objects/channel/message"
 	"github.com/rxdn/gdl/objects/guild/emoji"
 	"github.com/rxdn/gdl/objects/interaction/component"
 	"github.com/rxdn/gdl/rest"
@@ -35,6 +36,8 @@ func SendWelcomeMessage(
 	formData map[database.FormInput]string,
 	// Only custom integration placeholders for now - prevent making duplicate requests
 	additionalPlaceholders map[string]string,
+	// The staff member that the ticket was automatically assigned to, if any
+	assigneeId *uint64,
 ) (uint64, error) {
 	settings, err := dbclient.Client.Settings.Get(ctx, ticket.GuildId)
 	if err != nil {
@@ -79,7 +82,8 @@ func SendWelcomeMessage(
 		}),
 	}
 
-	if !settings.HideClaimButton && !ticket.IsThread {
+	// Tickets that have been assigned are claimed as soon as they are opened
+	if !settings.HideClaimButton && !ticket.IsThread && assigneeId == nil {
 		buttons = append(buttons, component.BuildButton(component.Button{
 			Label:    cmd.GetMessage(i18n.TitleClaim),
 			CustomId: "claim",
@@ -95,6 +99,13 @@ func SendWelcomeMessage(
 		},
 	}
 
+	if assigneeId != nil {
+		data.Content = cmd.GetMessage(i18n.MessageTicketAutoAssigned, *assigneeId)
+		data.AllowedMentions = message.AllowedMention{
+			Users: []uint64{*assigneeId},
+		}
+	}
+
 	// Should never happen
 	if ticket.ChannelId == nil {
 		return 0, fmt.Errorf("channel is nil")
@@ -416,6 +427,15 @@ var substitutions = map[string]PlaceholderSubstitutionFunc{
 	"discord_account_age": func(ctx context.Context, worker *worker.Context, ticket database.Ticket) string {
 		return fmt.Sprintf("<t:%d:R>", utils.SnowflakeToTime(ticket.UserId).Unix())
 	},
+	"priority": func(ctx context.Context, worker *worker.Context, ticket database.Ticket) string {
+		priority, err := GetTicketPriority(ctx, ticket)
+		if err != nil {
+			fmt.Print(err)
+			return ""
+		}
+
+		return strings.ToLower(string(priority))
+	},
 }
 
 type GroupSubstitutionFunc func(context.Context, *worker.Context, database.Ticket) map[string]string
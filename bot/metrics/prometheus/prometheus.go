@@ -30,6 +30,7 @@ var (
 	InboundRequests           = newCounterVec("inbound_requests", "route")
 	ActiveInteractions        = newGauge("active_interactions")
 	InteractionTimeToComplete = newHistogram("interaction_time_to_complete")
+	DuplicateInteractions     = newCounterVec("duplicate_interactions", "interaction_type")
 
 	ForwardedDashboardMessages = newCounter("forwarded_dashboard_messages")
 
@@ -37,6 +38,10 @@ var (
 	KafkaBatchSize = newHistogram("kafka_batch_size")
 	KafkaMessages  = newHistogramVec("kafka_messages", "topic")
 
+	EventRetries       = newCounter("event_retries")
+	DeadLetteredEvents = newCounter("dead_lettered_events")
+	EventLaneDepth     = newGaugeVec("event_lane_depth", "lane")
+
 	CategoryUpdates = newCounter("category_updates")
 )
 
@@ -80,6 +85,14 @@ func newGauge(name string) prometheus.Gauge {
 	})
 }
 
+func newGaugeVec(name string, labels ...string) *prometheus.GaugeVec {
+	return promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: Namespace,
+		Subsystem: Subsystem,
+		Name:      name,
+	}, labels)
+}
+
 func LogIntegrationRequest(integration database.CustomIntegration, guildId uint64) {
 	IntegrationRequests.WithLabelValues(
 		strconv.Itoa(integration.Id),
@@ -0,0 +1,16 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+)
+
+func autoAssignTurnKey(guildId uint64) string {
+	return fmt.Sprintf("tickets:autoassign:turn:%d", guildId)
+}
+
+// NextAutoAssignTurn returns the number of tickets that have been assigned in the guild with round-robin, including
+// this one. The counter is incremented atomically, so that tickets opened at the same time are given different turns.
+func NextAutoAssignTurn(ctx context.Context, guildId uint64) (int64, error) {
+	return Client.Incr(ctx, autoAssignTurnKey(guildId)).Result()
+}
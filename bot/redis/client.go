@@ -28,3 +28,13 @@ func Connect() error {
 
 	return nil
 }
+
+// NewPollerClient returns a client with its own connection, for a listener that pops work from a queue. Closing the
+// client stops the listener from popping any more work, without affecting Client.
+func NewPollerClient() *redis.Client {
+	options := *Client.Options()
+	options.PoolSize = 1
+	options.MinIdleConns = 0
+
+	return redis.NewClient(&options)
+}
@@ -0,0 +1,21 @@
+package redis
+
+import (
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/go-redis/redis/v8"
+)
+
+// useMiniredis points Client at a miniredis server for the duration of the test
+func useMiniredis(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	previous := Client
+	Client = redis.NewClient(&redis.Options{Addr: mr.Addr()})
+
+	t.Cleanup(func() {
+		_ = Client.Close()
+		Client = previous
+	})
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 )
 
+const commandRegistrationInterval = time.Minute * 10
+
 func LoadCommandIds(botId uint64) (map[string]uint64, error) {
 	data, err := Client.HGetAll(context.Background(), buildCommandIdKey(botId)).Result()
 	if err != nil {
@@ -45,3 +47,29 @@ func StoreCommandIds(botId uint64, commandIds map[string]uint64) error {
 func buildCommandIdKey(botId uint64) string {
 	return fmt.Sprintf("commandsids:%d", botId)
 }
+
+// GetCommandHash returns the hash of the payload that the bot's commands were last registered with, or ErrNil if the
+// commands have not been registered by the worker
+func GetCommandHash(ctx context.Context, botId uint64) (string, error) {
+	return Client.Get(ctx, buildCommandHashKey(botId)).Result()
+}
+
+// StoreCommandHash records that the bot's commands have been registered with the payload. The cached command IDs are
+// removed, as re-registering commands may change them.
+func StoreCommandHash(ctx context.Context, botId uint64, hash string) error {
+	tx := Client.TxPipeline()
+	tx.Set(ctx, buildCommandHashKey(botId), hash, 0)
+	tx.Del(ctx, buildCommandIdKey(botId))
+
+	_, err := tx.Exec(ctx)
+	return err
+}
+
+// TakeCommandRegistrationToken returns true if the bot's commands have not been re-registered recently
+func TakeCommandRegistrationToken(ctx context.Context, botId uint64) (bool, error) {
+	return Client.SetNX(ctx, fmt.Sprintf("commandsregistration:%d", botId), "1", commandRegistrationInterval).Result()
+}
+
+func buildCommandHashKey(botId uint64) string {
+	return fmt.Sprintf("commandshash:%d", botId)
+}
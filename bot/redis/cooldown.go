@@ -0,0 +1,58 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Returns 0 if a token was taken, otherwise the number of milliseconds until the next token is available. The current
+// time is passed in, rather than using the TIME command, so that the script is deterministic.
+var cooldownScript = redis.NewScript(`
+local capacity = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call("HMGET", KEYS[1], "tokens", "updated")
+local tokens = tonumber(state[1])
+local updated = tonumber(state[2])
+
+if not tokens or not updated then
+	tokens = capacity
+	updated = now
+end
+
+local elapsed = math.max(0, now - updated)
+tokens = math.min(capacity, tokens + elapsed * capacity / interval)
+
+if tokens < 1 then
+	return math.ceil((1 - tokens) * interval / capacity)
+end
+
+redis.call("HMSET", KEYS[1], "tokens", tostring(tokens - 1), "updated", tostring(now))
+redis.call("PEXPIRE", KEYS[1], interval)
+
+return 0
+`)
+
+// TakeCooldownToken takes a token from the bucket, which holds up to capacity tokens and is refilled over interval.
+// If the bucket is empty, the time until the next token is available is returned.
+func TakeCooldownToken(ctx context.Context, key string, capacity int, interval time.Duration) (bool, time.Duration, error) {
+	res, err := cooldownScript.Run(ctx, Client, []string{key}, capacity, interval.Milliseconds(), time.Now().UnixMilli()).Result()
+	if err != nil {
+		return false, 0, err
+	}
+
+	retryAfter, ok := res.(int64)
+	if !ok {
+		return false, 0, fmt.Errorf("cooldown script returned %v, not an int64", res)
+	}
+
+	if retryAfter > 0 {
+		return false, time.Duration(retryAfter) * time.Millisecond, nil
+	}
+
+	return true, 0, nil
+}
@@ -0,0 +1,39 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCooldownBucketEmpties(t *testing.T) {
+	useMiniredis(t)
+
+	for i := 0; i < 2; i++ {
+		allowed, _, err := TakeCooldownToken(context.Background(), "cooldown", 2, time.Minute)
+		require.NoError(t, err)
+		require.True(t, allowed)
+	}
+
+	allowed, retryAfter, err := TakeCooldownToken(context.Background(), "cooldown", 2, time.Minute)
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// One token is replenished every 30 seconds
+	require.Greater(t, retryAfter, time.Second*29)
+	require.LessOrEqual(t, retryAfter, time.Second*30)
+}
+
+func TestCooldownBucketsAreIndependent(t *testing.T) {
+	useMiniredis(t)
+
+	allowed, _, err := TakeCooldownToken(context.Background(), "cooldown:1", 1, time.Minute)
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, _, err = TakeCooldownToken(context.Background(), "cooldown:2", 1, time.Minute)
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
@@ -0,0 +1,82 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Tickets on hold until a given time are kept in a sorted set, scored by the unix time that they should resume at
+const holdTimersKey = "tickets:hold_timers"
+
+type HoldTimer struct {
+	GuildId  uint64
+	TicketId int
+}
+
+func (t HoldTimer) member() string {
+	return fmt.Sprintf("%d:%d", t.GuildId, t.TicketId)
+}
+
+func parseHoldTimer(member string) (HoldTimer, bool) {
+	guildRaw, ticketRaw, found := strings.Cut(member, ":")
+	if !found {
+		return HoldTimer{}, false
+	}
+
+	guildId, err := strconv.ParseUint(guildRaw, 10, 64)
+	if err != nil {
+		return HoldTimer{}, false
+	}
+
+	ticketId, err := strconv.Atoi(ticketRaw)
+	if err != nil {
+		return HoldTimer{}, false
+	}
+
+	return HoldTimer{
+		GuildId:  guildId,
+		TicketId: ticketId,
+	}, true
+}
+
+// ScheduleHoldTimer sets the time that the ticket should resume at, replacing any previous time
+func ScheduleHoldTimer(ctx context.Context, timer HoldTimer, until time.Time) error {
+	return Client.ZAdd(ctx, holdTimersKey, &redis.Z{
+		Score:  float64(until.Unix()),
+		Member: timer.member(),
+	}).Err()
+}
+
+func CancelHoldTimer(ctx context.Context, timer HoldTimer) error {
+	return Client.ZRem(ctx, holdTimersKey, timer.member()).Err()
+}
+
+// TakeDueHoldTimers leases and returns up to limit timers whose time has passed. Each timer is only returned to one
+// caller, even if several workers are taking timers at once. Timers that are not completed before the lease ends are
+// taken again.
+func TakeDueHoldTimers(ctx context.Context, now time.Time, limit int64) ([]HoldTimer, time.Time, error) {
+	members, lease, err := takeDueMembers(ctx, holdTimersKey, now, limit)
+	if err != nil {
+		return nil, lease, err
+	}
+
+	var timers []HoldTimer
+	for _, member := range members {
+		if timer, ok := parseHoldTimer(member); ok {
+			timers = append(timers, timer)
+		}
+	}
+
+	return timers, lease, nil
+}
+
+// CompleteHoldTimer removes the timer once it has been handled, unless the ticket has been held again since it was
+// taken
+func CompleteHoldTimer(ctx context.Context, timer HoldTimer, lease time.Time) error {
+	return completeMember(ctx, holdTimersKey, timer.member(), lease)
+}
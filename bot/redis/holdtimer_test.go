@@ -0,0 +1,46 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoldTimersReplaceUntil(t *testing.T) {
+	useMiniredis(t)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	extended := HoldTimer{GuildId: 1, TicketId: 2}
+	require.NoError(t, ScheduleHoldTimer(ctx, extended, now.Add(-time.Minute)))
+
+	// Holding the ticket again moves the time it resumes at
+	require.NoError(t, ScheduleHoldTimer(ctx, extended, now.Add(time.Hour)))
+
+	due := HoldTimer{GuildId: 1, TicketId: 3}
+	require.NoError(t, ScheduleHoldTimer(ctx, due, now.Add(-time.Minute)))
+
+	cancelled := HoldTimer{GuildId: 1, TicketId: 4}
+	require.NoError(t, ScheduleHoldTimer(ctx, cancelled, now.Add(-time.Minute)))
+	require.NoError(t, CancelHoldTimer(ctx, cancelled))
+
+	timers, lease, err := TakeDueHoldTimers(ctx, now, 10)
+	require.NoError(t, err)
+	require.Equal(t, []HoldTimer{due}, timers)
+
+	timers, _, err = TakeDueHoldTimers(ctx, now, 10)
+	require.NoError(t, err)
+	require.Empty(t, timers)
+
+	// Holding the ticket again while the timer is being handled keeps the new time
+	resumes := now.Add(time.Minute)
+	require.NoError(t, ScheduleHoldTimer(ctx, due, resumes))
+	require.NoError(t, CompleteHoldTimer(ctx, due, lease))
+
+	timers, _, err = TakeDueHoldTimers(ctx, resumes, 10)
+	require.NoError(t, err)
+	require.Equal(t, []HoldTimer{due}, timers)
+}
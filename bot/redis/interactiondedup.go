@@ -0,0 +1,56 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Interaction tokens are valid for 15 minutes, after which a redelivery could not be responded to anyway
+const interactionDedupExpiry = time.Minute * 15
+
+var ErrInteractionPending = errors.New("interaction has not been responded to yet")
+
+// ClaimInteraction marks the interaction as being handled. Returns false if an earlier delivery of the same interaction
+// has already claimed it.
+func ClaimInteraction(ctx context.Context, interactionId uint64) (bool, error) {
+	return Client.SetNX(ctx, buildInteractionDedupKey(interactionId), "", interactionDedupExpiry).Result()
+}
+
+// ReleaseInteraction removes the claim on the interaction, so that a redelivery is handled rather than waiting for a
+// response that will never be sent.
+func ReleaseInteraction(ctx context.Context, interactionId uint64) error {
+	return Client.Del(ctx, buildInteractionDedupKey(interactionId)).Err()
+}
+
+// StoreInteractionResponse stores the initial response sent for the interaction, so that it can be sent again if the
+// interaction is redelivered.
+func StoreInteractionResponse(ctx context.Context, interactionId uint64, response []byte) error {
+	return Client.Set(ctx, buildInteractionDedupKey(interactionId), response, interactionDedupExpiry).Err()
+}
+
+// GetInteractionResponse returns the initial response sent for the interaction. Returns ErrInteractionPending if the
+// interaction has been claimed, but not yet responded to.
+func GetInteractionResponse(ctx context.Context, interactionId uint64) ([]byte, error) {
+	res, err := Client.Get(ctx, buildInteractionDedupKey(interactionId)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, fmt.Errorf("interaction %d has not been claimed", interactionId)
+		}
+
+		return nil, err
+	}
+
+	if len(res) == 0 {
+		return nil, ErrInteractionPending
+	}
+
+	return res, nil
+}
+
+func buildInteractionDedupKey(interactionId uint64) string {
+	return fmt.Sprintf("tickets:interaction:%d", interactionId)
+}
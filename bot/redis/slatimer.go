@@ -0,0 +1,87 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+)
+
+// SLA deadlines are kept in a sorted set, scored by the unix time of the deadline, so that they survive restarts and
+// can be taken by any worker
+const slaTimersKey = "tickets:sla_timers"
+
+type SlaTimer struct {
+	GuildId  uint64
+	TicketId int
+	Type     database.SlaBreachType
+}
+
+func (t SlaTimer) member() string {
+	return fmt.Sprintf("%d:%d:%s", t.GuildId, t.TicketId, t.Type)
+}
+
+func parseSlaTimer(member string) (SlaTimer, bool) {
+	parts := strings.SplitN(member, ":", 3)
+	if len(parts) != 3 {
+		return SlaTimer{}, false
+	}
+
+	guildId, err := strconv.ParseUint(parts[0], 10, 64)
+	if err != nil {
+		return SlaTimer{}, false
+	}
+
+	ticketId, err := strconv.Atoi(parts[1])
+	if err != nil {
+		return SlaTimer{}, false
+	}
+
+	return SlaTimer{
+		GuildId:  guildId,
+		TicketId: ticketId,
+		Type:     database.SlaBreachType(parts[2]),
+	}, true
+}
+
+// ScheduleSlaTimer starts the timer, unless it is already running with an earlier deadline, which is kept. A timer that
+// has been taken, but not completed, is replaced, so that completing the old deadline does not remove it.
+func ScheduleSlaTimer(ctx context.Context, timer SlaTimer, deadline time.Time) error {
+	return scheduleEarliestMember(ctx, slaTimersKey, timer.member(), deadline)
+}
+
+func CancelSlaTimers(ctx context.Context, guildId uint64, ticketId int, types ...database.SlaBreachType) error {
+	members := make([]interface{}, len(types))
+	for i, breachType := range types {
+		members[i] = SlaTimer{GuildId: guildId, TicketId: ticketId, Type: breachType}.member()
+	}
+
+	return Client.ZRem(ctx, slaTimersKey, members...).Err()
+}
+
+// TakeDueSlaTimers leases and returns up to limit timers whose deadline has passed. Each timer is only returned to one
+// caller, even if several workers are taking timers at once. Timers that are not completed before the lease ends are
+// taken again.
+func TakeDueSlaTimers(ctx context.Context, now time.Time, limit int64) ([]SlaTimer, time.Time, error) {
+	members, lease, err := takeDueMembers(ctx, slaTimersKey, now, limit)
+	if err != nil {
+		return nil, lease, err
+	}
+
+	var timers []SlaTimer
+	for _, member := range members {
+		if timer, ok := parseSlaTimer(member); ok {
+			timers = append(timers, timer)
+		}
+	}
+
+	return timers, lease, nil
+}
+
+// CompleteSlaTimer removes the timer once it has been handled, unless it has been started again since it was taken
+func CompleteSlaTimer(ctx context.Context, timer SlaTimer, lease time.Time) error {
+	return completeMember(ctx, slaTimersKey, timer.member(), lease)
+}
@@ -0,0 +1,84 @@
+package redis
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSlaTimersKeepEarliestDeadline(t *testing.T) {
+	useMiniredis(t)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	first := SlaTimer{GuildId: 1, TicketId: 2, Type: database.SlaBreachTypeNextResponse}
+	require.NoError(t, ScheduleSlaTimer(ctx, first, now.Add(-time.Minute)))
+
+	// A later message must not push the deadline back
+	require.NoError(t, ScheduleSlaTimer(ctx, first, now.Add(time.Hour)))
+
+	cancelled := SlaTimer{GuildId: 1, TicketId: 3, Type: database.SlaBreachTypeFirstResponse}
+	require.NoError(t, ScheduleSlaTimer(ctx, cancelled, now.Add(-time.Minute)))
+	require.NoError(t, CancelSlaTimers(ctx, 1, 3, database.SlaBreachTypeFirstResponse, database.SlaBreachTypeNextResponse))
+
+	timers, lease, err := TakeDueSlaTimers(ctx, now, 10)
+	require.NoError(t, err)
+	require.Equal(t, []SlaTimer{first}, timers)
+
+	// Timers are only taken once while they are leased
+	timers, _, err = TakeDueSlaTimers(ctx, now, 10)
+	require.NoError(t, err)
+	require.Empty(t, timers)
+
+	require.NoError(t, CompleteSlaTimer(ctx, first, lease))
+
+	timers, _, err = TakeDueSlaTimers(ctx, lease.Add(time.Second), 10)
+	require.NoError(t, err)
+	require.Empty(t, timers)
+}
+
+func TestSlaTimersAreTakenAgainAfterLease(t *testing.T) {
+	useMiniredis(t)
+
+	ctx := context.Background()
+	now := time.Now()
+
+	timer := SlaTimer{GuildId: 1, TicketId: 2, Type: database.SlaBreachTypeFirstResponse}
+	require.NoError(t, ScheduleSlaTimer(ctx, timer, now.Add(-time.Minute)))
+
+	_, lease, err := TakeDueSlaTimers(ctx, now, 10)
+	require.NoError(t, err)
+
+	// The worker handling the timer failed, so it is not completed
+	timers, _, err := TakeDueSlaTimers(ctx, lease.Add(time.Second), 10)
+	require.NoError(t, err)
+	require.Equal(t, []SlaTimer{timer}, timers)
+}
+
+func TestSlaTimerRestartedWhileLeasedIsKept(t *testing.T) {
+	// Deadlines before and after the end of the lease
+	for _, target := range []time.Duration{time.Minute, time.Hour} {
+		useMiniredis(t)
+
+		ctx := context.Background()
+		now := time.Now()
+
+		timer := SlaTimer{GuildId: 1, TicketId: 2, Type: database.SlaBreachTypeNextResponse}
+		require.NoError(t, ScheduleSlaTimer(ctx, timer, now.Add(-time.Minute)))
+
+		_, lease, err := TakeDueSlaTimers(ctx, now, 10)
+		require.NoError(t, err)
+
+		deadline := now.Add(target)
+		require.NoError(t, ScheduleSlaTimer(ctx, timer, deadline))
+		require.NoError(t, CompleteSlaTimer(ctx, timer, lease))
+
+		timers, _, err := TakeDueSlaTimers(ctx, deadline, 10)
+		require.NoError(t, err)
+		require.Equal(t, []SlaTimer{timer}, timers)
+	}
+}
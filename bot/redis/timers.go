@@ -0,0 +1,79 @@
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+// Timers are leased, rather than removed, when they are taken: their deadline is moved to the end of the lease, so
+// that they are taken again if the worker handling them fails, or stops, before completing them.
+const timerLease = time.Minute * 5
+
+// Deadlines are whole unix seconds, so the end of a lease is scored half a second later, to tell leased members apart
+// from members waiting for their deadline.
+const leaseOffset = 0.5
+
+// Takes up to ARGV[2] members whose score has passed ARGV[1], and moves their scores to the end of the lease, ARGV[3].
+// Running as a script means that each member is only taken by one caller, even if several workers take at once.
+var takeDueMembersScript = redis.NewScript(`
+local members = redis.call("ZRANGEBYSCORE", KEYS[1], "-inf", ARGV[1], "LIMIT", 0, ARGV[2])
+for _, member in ipairs(members) do
+	redis.call("ZADD", KEYS[1], "XX", ARGV[3], member)
+end
+
+return members
+`)
+
+// Sets the deadline of the member ARGV[1] to ARGV[2], unless it is already waiting for an earlier deadline. A leased
+// member is always given the new deadline, so that it is not removed when the lease is completed.
+var scheduleEarliestMemberScript = redis.NewScript(`
+local score = redis.call("ZSCORE", KEYS[1], ARGV[1])
+if not score or tonumber(score) % 1 ~= 0 or tonumber(ARGV[2]) < tonumber(score) then
+	return redis.call("ZADD", KEYS[1], ARGV[2], ARGV[1])
+end
+
+return 0
+`)
+
+// Removes the member ARGV[1], only if its score is still the end of the lease, ARGV[2]. A deadline set while the
+// member was being handled is kept.
+var completeMemberScript = redis.NewScript(`
+if tonumber(redis.call("ZSCORE", KEYS[1], ARGV[1])) == tonumber(ARGV[2]) then
+	return redis.call("ZREM", KEYS[1], ARGV[1])
+end
+
+return 0
+`)
+
+// takeDueMembers leases and returns up to limit members of the sorted set whose score, a unix time, has passed. The
+// members must be completed before the returned lease ends, otherwise they are taken again.
+func takeDueMembers(ctx context.Context, key string, now time.Time, limit int64) ([]string, time.Time, error) {
+	lease := now.Add(timerLease)
+
+	members, err := takeDueMembersScript.Run(ctx, Client, []string{key},
+		strconv.FormatInt(now.Unix(), 10),
+		limit,
+		leaseScore(lease),
+	).StringSlice()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	return members, lease, nil
+}
+
+// scheduleEarliestMember sets the deadline of the member, unless it is already waiting for an earlier deadline
+func scheduleEarliestMember(ctx context.Context, key, member string, deadline time.Time) error {
+	return scheduleEarliestMemberScript.Run(ctx, Client, []string{key}, member, strconv.FormatInt(deadline.Unix(), 10)).Err()
+}
+
+func completeMember(ctx context.Context, key, member string, lease time.Time) error {
+	return completeMemberScript.Run(ctx, Client, []string{key}, member, leaseScore(lease)).Err()
+}
+
+func leaseScore(lease time.Time) string {
+	return strconv.FormatFloat(float64(lease.Unix())+leaseOffset, 'f', 1, 64)
+}
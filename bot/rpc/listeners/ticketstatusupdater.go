@@ -7,6 +7,8 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Utilities/rpc"
 	"github.com/jadevelopmentgrp/Tickets-Utilities/rpc/model"
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/logic"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 	"github.com/rxdn/gdl/cache"
@@ -42,6 +44,12 @@ func (u *TicketStatusUpdater) HandleMessage(ctx context.Context, message []byte)
 		return
 	}
 
+	// Tickets on hold, and high priority tickets, stay in their own categories whatever their status
+	if err := u.applyCategoryOverrides(ctx, &event); err != nil {
+		u.logger.Error("Failed to apply category overrides", zap.Error(err), zap.Uint64("guild_id", event.GuildId), zap.Int("ticket_id", event.TicketId))
+		return
+	}
+
 	canMove, err := u.CategoryHasSpace(ctx, worker, event)
 	if err != nil {
 		u.logger.Error("Failed to check category space", zap.Error(err))
@@ -95,6 +103,45 @@ func (u *TicketStatusUpdater) HandleMessage(ctx context.Context, message []byte)
 	u.logger.Debug("Moved ticket to updated status category", zap.Uint64("channel_id", event.ChannelId), zap.Uint64("category_id", event.NewCategoryId))
 }
 
+func (u *TicketStatusUpdater) applyCategoryOverrides(ctx context.Context, event *model.TicketStatusUpdate) error {
+	ticket, err := dbclient.Client.Tickets.Get(ctx, event.TicketId, event.GuildId)
+	if err != nil {
+		return err
+	}
+
+	priority, err := logic.GetTicketPriority(ctx, ticket)
+	if err != nil {
+		return err
+	}
+
+	onHold, err := logic.IsOnHold(ctx, ticket)
+	if err != nil {
+		return err
+	}
+
+	if !onHold && !logic.IsHighPriority(priority) {
+		return nil
+	}
+
+	holdSettings, err := dbclient.Client.HoldSettings.Get(ctx, event.GuildId)
+	if err != nil {
+		return err
+	}
+
+	prioritySettings, err := dbclient.Client.PrioritySettings.Get(ctx, event.GuildId)
+	if err != nil {
+		return err
+	}
+
+	if onHold && holdSettings.OnHoldCategoryId != nil {
+		event.NewCategoryId = *holdSettings.OnHoldCategoryId
+	} else if categoryId, ok := logic.PriorityCategory(prioritySettings, priority); ok {
+		event.NewCategoryId = categoryId
+	}
+
+	return nil
+}
+
 func (u *TicketStatusUpdater) CategoryHasSpace(ctx context.Context, worker *worker.Context, event model.TicketStatusUpdate) (bool, error) {
 	channels, err := u.cache.GetGuildChannels(ctx, event.GuildId)
 	if err != nil {
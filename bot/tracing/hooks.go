@@ -0,0 +1,102 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type requestSpanKey struct{}
+
+var (
+	apiVersionPattern = regexp.MustCompile(`^/api/v\d+`)
+	snowflakePattern  = regexp.MustCompile(`/\d{15,20}(/|$)`)
+	tokenPattern      = regexp.MustCompile(`^(/(?:webhooks|interactions)/:id)/[^/]+`)
+)
+
+// PreRequestHook starts a span for a request to Discord, as a child of the span carried by the request's context. The
+// trace is propagated to the http-proxy in the request headers.
+func PreRequestHook(_ string, req *http.Request) {
+	if !enabled || !HasSpan(req.Context()) {
+		return
+	}
+
+	route := routeName(req.URL.Path)
+
+	ctx, span := Tracer.Start(req.Context(), fmt.Sprintf("%s %s", req.Method, route),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", req.Method),
+			attribute.String("http.route", route),
+		),
+	)
+
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	ctx = context.WithValue(ctx, requestSpanKey{}, span)
+	*req = *req.WithContext(ctx)
+}
+
+// PostRequestHook ends the span started by PreRequestHook. gdl calls post request hooks with a nil response if the
+// request fails without one, in which case the span is ended by the transport returned by WrapTransport instead.
+func PostRequestHook(res *http.Response, _ []byte) {
+	if res == nil {
+		return
+	}
+
+	span, ok := res.Request.Context().Value(requestSpanKey{}).(trace.Span)
+	if !ok {
+		return
+	}
+
+	span.SetAttributes(attribute.Int("http.status_code", res.StatusCode))
+	if res.StatusCode >= 400 {
+		span.SetStatus(codes.Error, http.StatusText(res.StatusCode))
+	}
+
+	span.End()
+}
+
+type transport struct {
+	base http.RoundTripper
+}
+
+// WrapTransport returns a transport which ends the span started by PreRequestHook, with an error status, when a request
+// fails without a response. base may be nil, in which case http.DefaultTransport is used.
+func WrapTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return transport{base: base}
+}
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		if span, ok := req.Context().Value(requestSpanKey{}).(trace.Span); ok {
+			RecordError(span, err)
+			span.End()
+		}
+	}
+
+	return res, err
+}
+
+// routeName removes IDs and interaction tokens from the path, so that spans for the same route share a name
+func routeName(path string) string {
+	path = apiVersionPattern.ReplaceAllString(path, "")
+
+	for snowflakePattern.MatchString(path) {
+		path = snowflakePattern.ReplaceAllString(path, "/:id$1")
+	}
+
+	return tokenPattern.ReplaceAllString(path, "$1/:token")
+}
@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestRouteNameChannel(t *testing.T) {
+	require.Equal(t, "/channels/:id/messages/:id", routeName("/api/v10/channels/1022471243373928539/messages/1022471243373928540"))
+}
+
+func TestRouteNameInteractionToken(t *testing.T) {
+	require.Equal(t, "/webhooks/:id/:token/messages/@original", routeName("/api/v10/webhooks/508391840525975553/aW50ZXJhY3Rpb246MTAy/messages/@original"))
+	require.Equal(t, "/interactions/:id/:token/callback", routeName("/api/v10/interactions/1022471243373928539/aW50ZXJhY3Rpb246MTAy/callback"))
+}
+
+func TestRouteNameNoIds(t *testing.T) {
+	require.Equal(t, "/users/@me", routeName("/api/v10/users/@me"))
+}
+
+func TestTransportEndsSpanOnError(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	ctx, span := provider.Tracer("test").Start(context.Background(), "GET /users/@me")
+	ctx = context.WithValue(ctx, requestSpanKey{}, span)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://discord.test/api/v10/users/@me", nil)
+	require.NoError(t, err)
+
+	failing := roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	})
+
+	_, err = WrapTransport(failing).RoundTrip(req)
+	require.Error(t, err)
+
+	ended := recorder.Ended()
+	require.Len(t, ended, 1)
+	require.Equal(t, codes.Error, ended[0].Status().Code)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,151 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const (
+	maxPendingTraces = 10000
+
+	// Interaction tokens are valid for 15 minutes, so no trace should take longer than this
+	pendingTraceTimeout = time.Minute * 15
+)
+
+// slowTraceProcessor logs traces that took longer than the threshold, with a breakdown of the spans within them. It
+// is used in place of an exporter when no collector is configured.
+type slowTraceProcessor struct {
+	logger    *zap.Logger
+	threshold time.Duration
+
+	mu     sync.Mutex
+	traces map[trace.TraceID]*pendingTrace
+}
+
+type pendingTrace struct {
+	firstSeen time.Time
+	spans     []sdktrace.ReadOnlySpan
+}
+
+var _ sdktrace.SpanProcessor = (*slowTraceProcessor)(nil)
+
+func newSlowTraceProcessor(logger *zap.Logger, threshold time.Duration) *slowTraceProcessor {
+	return &slowTraceProcessor{
+		logger:    logger,
+		threshold: threshold,
+		traces:    make(map[trace.TraceID]*pendingTrace),
+	}
+}
+
+func (p *slowTraceProcessor) OnStart(context.Context, sdktrace.ReadWriteSpan) {}
+
+// OnEnd holds on to spans until the root span of their trace ends, which happens after all of its children
+func (p *slowTraceProcessor) OnEnd(span sdktrace.ReadOnlySpan) {
+	traceId := span.SpanContext().TraceID()
+
+	p.mu.Lock()
+
+	if !isLocalRoot(span) {
+		defer p.mu.Unlock()
+
+		pending, ok := p.traces[traceId]
+		if !ok {
+			if len(p.traces) >= maxPendingTraces {
+				p.prune()
+
+				if len(p.traces) >= maxPendingTraces {
+					return
+				}
+			}
+
+			pending = &pendingTrace{firstSeen: time.Now()}
+			p.traces[traceId] = pending
+		}
+
+		pending.spans = append(pending.spans, span)
+		return
+	}
+
+	pending := p.traces[traceId]
+	delete(p.traces, traceId)
+	p.mu.Unlock()
+
+	if duration(span) < p.threshold {
+		return
+	}
+
+	var children []sdktrace.ReadOnlySpan
+	if pending != nil {
+		children = pending.spans
+	}
+
+	p.log(span, children)
+}
+
+func (p *slowTraceProcessor) Shutdown(context.Context) error {
+	return nil
+}
+
+func (p *slowTraceProcessor) ForceFlush(context.Context) error {
+	return nil
+}
+
+// prune removes traces whose root span never ended. Must be called with the lock held.
+func (p *slowTraceProcessor) prune() {
+	for traceId, pending := range p.traces {
+		if time.Since(pending.firstSeen) > pendingTraceTimeout {
+			delete(p.traces, traceId)
+		}
+	}
+}
+
+func (p *slowTraceProcessor) log(root sdktrace.ReadOnlySpan, children []sdktrace.ReadOnlySpan) {
+	slices.SortFunc(children, func(a, b sdktrace.ReadOnlySpan) int {
+		return a.StartTime().Compare(b.StartTime())
+	})
+
+	depths := map[trace.SpanID]int{
+		root.SpanContext().SpanID(): 0,
+	}
+
+	lines := make([]string, 0, len(children))
+	for _, child := range children {
+		depth := depths[child.Parent().SpanID()] + 1
+		depths[child.SpanContext().SpanID()] = depth
+
+		lines = append(lines, fmt.Sprintf("%s%s %s (at +%s)",
+			strings.Repeat("  ", depth-1),
+			child.Name(),
+			duration(child),
+			child.StartTime().Sub(root.StartTime()),
+		))
+	}
+
+	fields := []zap.Field{
+		zap.String("trace_id", root.SpanContext().TraceID().String()),
+		zap.Duration("duration", duration(root)),
+		zap.Strings("spans", lines),
+	}
+
+	for _, attr := range root.Attributes() {
+		fields = append(fields, zap.String(string(attr.Key), attr.Value.Emit()))
+	}
+
+	p.logger.Warn(fmt.Sprintf("Slow trace: %s", root.Name()), fields...)
+}
+
+func isLocalRoot(span sdktrace.ReadOnlySpan) bool {
+	return !span.Parent().IsValid() || span.Parent().IsRemote()
+}
+
+func duration(span sdktrace.ReadOnlySpan) time.Duration {
+	return span.EndTime().Sub(span.StartTime())
+}
@@ -0,0 +1,84 @@
+package tracing
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const serviceName = "tickets-worker"
+
+// Tracer is safe to use before Configure is called, and when tracing is disabled, in which case spans are not recorded
+var Tracer = otel.Tracer("github.com/jadevelopmentgrp/Tickets-Worker")
+
+var enabled bool
+
+// Configure sets the global tracer provider. Spans are exported to the OTLP collector if one is configured, otherwise
+// traces slower than the configured threshold are logged. If neither is configured, tracing is left disabled. The
+// returned function flushes any remaining spans.
+func Configure(logger *zap.Logger) (func(context.Context) error, error) {
+	conf := config.Conf.Tracing
+
+	var processor sdktrace.SpanProcessor
+	if conf.OtlpEndpoint != "" {
+		options := []otlptracehttp.Option{otlptracehttp.WithEndpoint(conf.OtlpEndpoint)}
+		if conf.OtlpInsecure {
+			options = append(options, otlptracehttp.WithInsecure())
+		}
+
+		exporter, err := otlptracehttp.New(context.Background(), options...)
+		if err != nil {
+			return nil, err
+		}
+
+		processor = sdktrace.NewBatchSpanProcessor(exporter)
+	} else if conf.SlowThreshold > 0 {
+		processor = newSlowTraceProcessor(logger, conf.SlowThreshold)
+	} else {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(processor),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(conf.SampleRate))),
+		sdktrace.WithResource(resource.NewSchemaless(attribute.String("service.name", serviceName))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	enabled = true
+
+	return provider.Shutdown, nil
+}
+
+func Enabled() bool {
+	return enabled
+}
+
+// ExtractFromHeaders returns a context carrying the trace propagated in the headers, if there is one. It is not tied to
+// the lifetime of the request, as interactions and events continue to be handled after the response is sent.
+func ExtractFromHeaders(header http.Header) context.Context {
+	return otel.GetTextMapPropagator().Extract(context.Background(), propagation.HeaderCarrier(header))
+}
+
+// HasSpan returns true if the context carries a span that is part of a trace. Spans for database queries and Discord
+// requests are only recorded as children of an event or interaction, rather than starting traces of their own.
+func HasSpan(ctx context.Context) bool {
+	return trace.SpanContextFromContext(ctx).IsValid()
+}
+
+// RecordError marks the span as failed
+func RecordError(span trace.Span, err error) {
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
@@ -0,0 +1,22 @@
+package utils
+
+import (
+	"sync"
+	"time"
+)
+
+// WaitTimeout waits for the WaitGroup to complete, returning false if the timeout is reached first
+func WaitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
+	ch := make(chan struct{})
+	go func() {
+		defer close(ch)
+		wg.Wait()
+	}()
+
+	select {
+	case <-ch:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
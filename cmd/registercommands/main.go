@@ -5,10 +5,10 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"os"
 
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/manager"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
-	"github.com/rxdn/gdl/rest"
 )
 
 var (
@@ -18,6 +18,7 @@ var (
 
 	AdminCommandGuildId = flag.Uint64("admin-guild", 0, "Guild to create the admin commands in")
 	MergeGuildCommands  = flag.Bool("merge", true, "Don't overwrite existing commands")
+	Plan                = flag.Bool("plan", false, "Print the changes that would be made, without making them")
 )
 
 func main() {
@@ -31,20 +32,57 @@ func main() {
 	commandManager := new(manager.CommandManager)
 	commandManager.RegisterCommands()
 
-	data := commandManager.BuildCreatePayload()
-
-	var err error
 	if *GuildId == 0 {
-		must(rest.ModifyGlobalCommands(context.Background(), *Token, nil, *ApplicationId, data))
+		register("global commands", commandManager.BuildCreatePayload(), func() ([]manager.CommandData, error) {
+			return manager.GetGlobalCommands(context.Background(), *Token, nil, *ApplicationId)
+		}, func(data []manager.CommandData) ([]manager.CommandData, error) {
+			if _, err := manager.ModifyGlobalCommands(context.Background(), *Token, nil, *ApplicationId, data); err != nil {
+				return nil, err
+			}
+
+			return manager.GetGlobalCommands(context.Background(), *Token, nil, *ApplicationId)
+		})
 	} else {
-		must(rest.ModifyGuildCommands(context.Background(), *Token, nil, *ApplicationId, *GuildId, data))
+		registerGuild(fmt.Sprintf("commands in guild %d", *GuildId), *GuildId, commandManager.BuildCreatePayload())
 	}
 
-	if err != nil {
-		panic(err)
+	adminCommands := commandManager.BuildAdminCreatePayload()
+	if *AdminCommandGuildId != 0 {
+		registerGuild(fmt.Sprintf("admin commands in guild %d", *AdminCommandGuildId), *AdminCommandGuildId, adminCommands)
+	} else if len(adminCommands) > 0 {
+		fmt.Fprintf(os.Stderr, "Skipping %d admin commands, as -admin-guild is not set\n", len(adminCommands))
+	}
+}
+
+func registerGuild(target string, guildId uint64, desired []manager.CommandData) {
+	register(target, desired, func() ([]manager.CommandData, error) {
+		return manager.GetGuildCommands(context.Background(), *Token, nil, *ApplicationId, guildId)
+	}, func(data []manager.CommandData) ([]manager.CommandData, error) {
+		if _, err := manager.ModifyGuildCommands(context.Background(), *Token, nil, *ApplicationId, guildId, data); err != nil {
+			return nil, err
+		}
+
+		return manager.GetGuildCommands(context.Background(), *Token, nil, *ApplicationId, guildId)
+	})
+}
+
+// register compares the desired commands to the existing ones, and unless -plan is set, overwrites them. Commands not
+// known to the registry, such as tag aliases, are kept if -merge is set.
+func register(
+	target string,
+	desired []manager.CommandData,
+	fetch func() ([]manager.CommandData, error),
+	modify func([]manager.CommandData) ([]manager.CommandData, error),
+) {
+	existing := must(fetch())
+	p := buildPlan(existing, desired, *MergeGuildCommands)
+
+	if *Plan {
+		p.Print(os.Stdout, target)
+		return
 	}
 
-	cmds := must(rest.GetGlobalCommands(context.Background(), *Token, nil, *ApplicationId))
+	cmds := must(modify(p.Payload(desired)))
 	marshalled := must(json.MarshalIndent(cmds, "", "    "))
 
 	fmt.Println(string(marshalled))
@@ -0,0 +1,203 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/manager"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+// Commands of different types may share a name, e.g. a slash command and a context menu command
+type commandKey struct {
+	Name string
+	Type interaction.ApplicationCommandType
+}
+
+type plan struct {
+	Added   []manager.CommandData
+	Changed []change
+	Removed []manager.CommandData
+	Kept    []manager.CommandData // Commands not known to the registry, kept as -merge is set
+}
+
+type change struct {
+	Command manager.CommandData
+	Fields  []string
+}
+
+func buildPlan(existing, desired []manager.CommandData, merge bool) (p plan) {
+	existingByKey := make(map[commandKey]manager.CommandData)
+	for _, cmd := range existing {
+		existingByKey[keyOf(cmd)] = cmd
+	}
+
+	desiredKeys := make(map[commandKey]bool)
+	for _, cmd := range desired {
+		desiredKeys[keyOf(cmd)] = true
+
+		current, ok := existingByKey[keyOf(cmd)]
+		if !ok {
+			p.Added = append(p.Added, cmd)
+			continue
+		}
+
+		if fields := changedFields(current, cmd); len(fields) > 0 {
+			p.Changed = append(p.Changed, change{Command: cmd, Fields: fields})
+		}
+	}
+
+	for _, cmd := range existing {
+		if desiredKeys[keyOf(cmd)] {
+			continue
+		}
+
+		if merge {
+			p.Kept = append(p.Kept, cmd)
+		} else {
+			p.Removed = append(p.Removed, cmd)
+		}
+	}
+
+	return
+}
+
+// Payload returns the commands to overwrite the existing commands with
+func (p plan) Payload(desired []manager.CommandData) []manager.CommandData {
+	// Kept commands are sent with their IDs, so that they are not recreated
+	return append(desired[:len(desired):len(desired)], p.Kept...)
+}
+
+func (p plan) Print(w io.Writer, target string) {
+	if len(p.Added) == 0 && len(p.Changed) == 0 && len(p.Removed) == 0 {
+		fmt.Fprintf(w, "No changes to %s (%d kept)\n", target, len(p.Kept))
+		return
+	}
+
+	fmt.Fprintf(w, "Changes to %s:\n", target)
+
+	for _, cmd := range sorted(p.Added) {
+		fmt.Fprintf(w, "  + %s\n", displayName(cmd))
+	}
+
+	sort.Slice(p.Changed, func(i, j int) bool {
+		return displayName(p.Changed[i].Command) < displayName(p.Changed[j].Command)
+	})
+
+	for _, change := range p.Changed {
+		fmt.Fprintf(w, "  ~ %s %v\n", displayName(change.Command), change.Fields)
+	}
+
+	for _, cmd := range sorted(p.Removed) {
+		fmt.Fprintf(w, "  - %s\n", displayName(cmd))
+	}
+
+	for _, cmd := range sorted(p.Kept) {
+		fmt.Fprintf(w, "  = %s (not in registry, kept)\n", displayName(cmd))
+	}
+}
+
+func keyOf(cmd manager.CommandData) commandKey {
+	return commandKey{
+		Name: cmd.Name,
+		Type: cmd.Type,
+	}
+}
+
+// changedFields returns the names of the top level fields that differ between the commands, ignoring the ID. Fields
+// that are null, false, empty or absent are treated as equal, as Discord omits some of them when they are unset.
+func changedFields(a, b manager.CommandData) []string {
+	a.Id, b.Id = 0, 0
+
+	aFields, bFields := toFields(a), toFields(b)
+
+	names := make(map[string]bool)
+	for name := range aFields {
+		names[name] = true
+	}
+
+	for name := range bFields {
+		names[name] = true
+	}
+
+	var changed []string
+	for name := range names {
+		if !reflect.DeepEqual(aFields[name], bFields[name]) {
+			changed = append(changed, name)
+		}
+	}
+
+	sort.Strings(changed)
+	return changed
+}
+
+func toFields(cmd manager.CommandData) map[string]any {
+	marshalled := must(json.Marshal(cmd))
+
+	var fields map[string]any
+	must(0, json.Unmarshal(marshalled, &fields))
+
+	return normalise(fields).(map[string]any)
+}
+
+// normalise removes unset values from objects, recursively, see isEmpty
+func normalise(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		for name, field := range value {
+			field = normalise(field)
+			if isEmpty(field) {
+				delete(value, name)
+			} else {
+				value[name] = field
+			}
+		}
+
+		return value
+	case []any:
+		for i, element := range value {
+			value[i] = normalise(element)
+		}
+
+		return value
+	default:
+		return value
+	}
+}
+
+// isEmpty returns whether the value is treated as unset. Numbers are not, as a minimum or maximum of 0 is meaningful.
+func isEmpty(value any) bool {
+	switch value := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !value
+	case string:
+		return value == ""
+	case []any:
+		return len(value) == 0
+	case map[string]any:
+		return len(value) == 0
+	default:
+		return false
+	}
+}
+
+func displayName(cmd manager.CommandData) string {
+	if cmd.Type == interaction.ApplicationCommandTypeChatInput {
+		return "/" + cmd.Name
+	}
+
+	return cmd.Name
+}
+
+func sorted(cmds []manager.CommandData) []manager.CommandData {
+	sort.Slice(cmds, func(i, j int) bool {
+		return displayName(cmds[i]) < displayName(cmds[j])
+	})
+
+	return cmds
+}
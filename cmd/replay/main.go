@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+
+	archiverclient "github.com/jadevelopmentgrp/Tickets-Archiver-Client"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/observability"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/cache"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"github.com/jadevelopmentgrp/Tickets-Worker/event"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/rest/request"
+	"go.uber.org/zap"
+)
+
+var (
+	File        = flag.String("file", "", "Capture file (JSONL) to replay")
+	Response    = flag.String("response", "{}", "Body returned by the fake Discord API for every request")
+	AllowRemote = flag.Bool("allow-remote", false, "Allow replaying against a database, cache or Redis that is not on localhost")
+)
+
+// Replays a capture file, written by a worker with WORKER_CAPTURE_PATH set. Events and interactions are handled as if
+// they had been received live, using the database, cache and Redis configured in the environment, but every request
+// to Discord is sent to a fake API which prints it instead. Replaying writes tickets and settings to the database, so
+// it refuses to run against anything but localhost, unless -allow-remote is passed.
+func main() {
+	flag.Parse()
+	if *File == "" {
+		panic("no capture file")
+	}
+
+	config.Parse()
+
+	if !*AllowRemote {
+		for name, address := range map[string]string{
+			"DATABASE_HOST":     config.Conf.Database.Host,
+			"CACHE_HOST":        config.Conf.Cache.Host,
+			"WORKER_REDIS_ADDR": config.Conf.Redis.Address,
+		} {
+			if !isLocalAddress(address) {
+				panic(fmt.Sprintf("%s (%s) is not on localhost. Replay against a throwaway database, or pass -allow-remote.", name, address))
+			}
+		}
+	}
+
+	logger, err := observability.Configure(config.Conf.JsonLogs, config.Conf.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	fakeDiscord := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		fmt.Printf("-> %s %s %s\n", r.Method, r.URL.Path, strings.TrimSpace(string(body)))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(*Response))
+	}))
+	defer fakeDiscord.Close()
+
+	// Send requests to the fake API through the proxy hook. The proxy hook lets application routes go straight to
+	// Discord, so redirect those too.
+	config.Conf.Discord.ProxyUrl = fakeDiscord.Listener.Addr().String()
+	request.RegisterPreRequestHook(utils.ProxyHook)
+	request.RegisterPreRequestHook(func(token string, req *http.Request) {
+		req.URL.Scheme = "http"
+		req.URL.Host = config.Conf.Discord.ProxyUrl
+	})
+
+	// Captured interactions are stored in the format forwarded by the proxy, so they carry neither a signature nor the
+	// forwarded secret
+	config.Conf.Discord.PublicKey = ""
+	config.Conf.Discord.ForwardedInteractionSecret = ""
+
+	must(redis.Connect())
+	dbclient.Connect()
+	i18n.Init()
+
+	pgCache, err := cache.Connect(logger.With(zap.String("service", "cache")))
+	must(err)
+	cache.Client = &pgCache
+
+	utils.ArchiverClient = archiverclient.NewArchiverClient(
+		archiverclient.NewProxyRetriever(config.Conf.Archiver.Url),
+		[]byte(config.Conf.Archiver.AesKey),
+	)
+
+	f, err := os.Open(*File)
+	must(err)
+	defer f.Close()
+
+	replayer := event.NewReplayer(redis.Client, &pgCache)
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	for line := 1; scanner.Scan(); line++ {
+		var captured event.CapturedPayload
+		if err := json.Unmarshal(scanner.Bytes(), &captured); err != nil {
+			fmt.Printf("line %d: failed to parse: %v\n", line, err)
+			continue
+		}
+
+		fmt.Printf("line %d: replaying %s captured at %s\n", line, captured.Kind, captured.CapturedAt)
+
+		status, body, err := replayer.Replay(captured)
+		if err != nil {
+			fmt.Printf("line %d: %v\n", line, err)
+			continue
+		}
+
+		fmt.Printf("<- %d %s\n", status, strings.TrimSpace(string(body)))
+	}
+
+	must(scanner.Err())
+}
+
+func isLocalAddress(address string) bool {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address // No port
+	}
+
+	if host == "localhost" {
+		return true
+	}
+
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
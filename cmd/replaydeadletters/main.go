@@ -0,0 +1,132 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	archiverclient "github.com/jadevelopmentgrp/Tickets-Archiver-Client"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/observability"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/cache"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"github.com/jadevelopmentgrp/Tickets-Worker/event"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/rest/request"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+)
+
+var (
+	Topic       = flag.String("topic", "", "Dead-letter topic to replay (defaults to KAFKA_DEAD_LETTER_TOPIC)")
+	Group       = flag.String("group", "worker-dead-letter-replay", "Consumer group used to track replayed events")
+	IdleTimeout = flag.Duration("idle-timeout", time.Second*10, "Stop once no dead letters have been received for this long")
+	SkipFailed  = flag.Bool("skip-failed", false, "Commit events that fail to replay, rather than stopping")
+)
+
+func main() {
+	flag.Parse()
+	config.Parse()
+
+	if *Topic == "" {
+		*Topic = config.Conf.Kafka.DeadLetterTopic
+	}
+
+	if *Topic == "" {
+		panic("no dead-letter topic")
+	}
+
+	logger, err := observability.Configure(config.Conf.JsonLogs, config.Conf.LogLevel)
+	if err != nil {
+		panic(err)
+	}
+
+	must(redis.Connect())
+	dbclient.Connect()
+	i18n.Init()
+
+	pgCache, err := cache.Connect(logger.With(zap.String("service", "cache")))
+	must(err)
+	cache.Client = &pgCache
+
+	if config.Conf.Discord.ProxyUrl != "" {
+		request.Client.Timeout = config.Conf.Discord.RequestTimeout
+		request.RegisterPreRequestHook(utils.ProxyHook)
+	}
+
+	utils.ArchiverClient = archiverclient.NewArchiverClient(
+		archiverclient.NewProxyRetriever(config.Conf.Archiver.Url),
+		[]byte(config.Conf.Archiver.AesKey),
+	)
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(config.Conf.Kafka.Brokers...),
+		kgo.ConsumerGroup(*Group),
+		kgo.ConsumeTopics(*Topic),
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtStart()),
+		kgo.DisableAutoCommit(),
+	)
+	must(err)
+	defer client.Close()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var replayed, failed int
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, *IdleTimeout)
+		fetches := client.PollFetches(pollCtx)
+		cancel()
+
+		for _, err := range fetches.Errors() {
+			// The poll context expiring means that there are no more dead letters
+			if !errors.Is(err.Err, context.DeadlineExceeded) && !errors.Is(err.Err, context.Canceled) {
+				logger.Error("Failed to fetch dead letters", zap.String("topic", err.Topic), zap.Error(err.Err))
+			}
+		}
+
+		if ctx.Err() != nil || fetches.IsClientClosed() || fetches.Empty() {
+			break
+		}
+
+		for _, record := range fetches.Records() {
+			var deadLetter event.DeadLetter
+			if err := json.Unmarshal(record.Value, &deadLetter); err != nil {
+				logger.Error("Failed to unmarshal dead letter", zap.Int64("offset", record.Offset), zap.Error(err))
+			} else if err := event.ReplayDeadLetter(&pgCache, deadLetter); err != nil {
+				logger.Error(
+					"Failed to replay event",
+					zap.Int64("offset", record.Offset),
+					zap.String("original_error", deadLetter.Error),
+					zap.Error(err),
+				)
+			} else {
+				replayed++
+				must(client.CommitRecords(ctx, record))
+				continue
+			}
+
+			failed++
+			if !*SkipFailed {
+				logger.Fatal("Stopping, as the event could not be replayed. Use -skip-failed to continue past it.")
+			}
+
+			must(client.CommitRecords(ctx, record))
+		}
+	}
+
+	logger.Info("Finished replaying dead letters", zap.Int("replayed", replayed), zap.Int("failed", failed))
+}
+
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
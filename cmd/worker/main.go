@@ -1,10 +1,10 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	_ "net/http/pprof"
-	"os"
 	"os/signal"
 	"sync"
 	"syscall"
@@ -23,6 +23,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/statsd"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/rpc/listeners"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/tracing"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/config"
 	"github.com/jadevelopmentgrp/Tickets-Worker/event"
@@ -55,6 +56,13 @@ func main() {
 		panic(err)
 	}
 
+	logger.Info("Configuring tracing")
+	shutdownTracing, err := tracing.Configure(logger.With(zap.String("service", "tracing")))
+	if err != nil {
+		logger.Fatal("Failed to configure tracing", zap.Error(err))
+		return
+	}
+
 	logger.Info("Connecting to Redis")
 	if err := redis.Connect(); err != nil {
 		logger.Fatal("Failed to connect to Redis", zap.Error(err))
@@ -92,6 +100,20 @@ func main() {
 		request.RegisterPreRequestHook(utils.ProxyHook)
 	}
 
+	if config.Conf.Capture.Path != "" {
+		logger.Info(
+			"Capturing events and interactions",
+			zap.String("path", config.Conf.Capture.Path),
+			zap.Float64("sample_rate", config.Conf.Capture.SampleRate),
+			zap.Uint64s("guild_ids", config.Conf.Capture.GuildIds),
+		)
+
+		if err := event.StartCapture(config.Conf.Capture.Path, config.Conf.Capture.SampleRate, config.Conf.Capture.GuildIds); err != nil {
+			logger.Fatal("Failed to open capture file", zap.Error(err))
+			return
+		}
+	}
+
 	logger.Info("Configuring microservice clients (no I/O)")
 
 	utils.ArchiverClient = archiverclient.NewArchiverClient(
@@ -117,26 +139,101 @@ func main() {
 	request.RegisterPreRequestHook(prometheus.PreRequestHook)
 	request.RegisterPostRequestHook(prometheus.PostRequestHook)
 
+	if tracing.Enabled() {
+		logger.Info("Registering tracing hooks")
+		request.RegisterPreRequestHook(tracing.PreRequestHook)
+		request.RegisterPostRequestHook(tracing.PostRequestHook)
+		request.Client.Transport = tracing.WrapTransport(request.Client.Transport)
+	}
+
 	logger.Info("Initialising integrations")
 	integrations.InitIntegrations()
 
-	go messagequeue.ListenTicketClose()
-	go messagequeue.ListenAutoClose()
-	go messagequeue.ListenCloseRequestTimer()
+	logger.Info("Loading blacklist cache")
+	if err := blacklist.RefreshCache(context.Background()); err != nil {
+		logger.Fatal("Failed to load blacklist cache", zap.Error(err))
+		return
+	}
+
+	// i18n, the blacklist cache and integrations have all been loaded
+	event.MarkReady()
+
+	shutdownCtx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	go messagequeue.ListenTicketClose(shutdownCtx)
+	go messagequeue.ListenAutoClose(shutdownCtx)
+	go messagequeue.ListenCloseRequestTimer(shutdownCtx)
+	go messagequeue.ListenSlaTimers(shutdownCtx)
+	go messagequeue.ListenHoldTimers(shutdownCtx)
 
 	go blacklist.StartCacheRefreshLoop(logger.With(zap.String("service", "blacklist_refresh")))
 
 	if config.Conf.WorkerMode == config.WorkerModeInteractions {
 		logger.Info("Starting HTTP server", zap.String("mode", string(config.Conf.WorkerMode)))
 
-		event.HttpListen(redis.Client, &pgCache)
+		// Blocks until a shutdown signal is received and in-flight interactions have completed
+		event.HttpListen(shutdownCtx, redis.Client, &pgCache)
+		logger.Info("HTTP server stopped")
 	} else if config.Conf.WorkerMode == config.WorkerModeGateway {
 		logger.Info("Starting event listeners", zap.String("mode", string(config.Conf.WorkerMode)))
 
-		go event.HttpListen(redis.Client, &pgCache)
-
 		var wg sync.WaitGroup
 
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			event.HttpListen(shutdownCtx, redis.Client, &pgCache)
+		}()
+
+		var deadLetter *event.DeadLetterProducer
+		if config.Conf.Kafka.DeadLetterTopic != "" {
+			deadLetter, err = event.NewDeadLetterProducer(config.Conf.Kafka.Brokers, config.Conf.Kafka.DeadLetterTopic)
+			if err != nil {
+				logger.Fatal("Failed to create dead-letter producer", zap.Error(err))
+				return
+			}
+
+			defer deadLetter.Close()
+		}
+
+		kafkaListener := event.NewKafkaListener(
+			logger.With(zap.String("service", "gateway-events-kafka")),
+			&pgCache,
+			deadLetter,
+		)
+
+		rpcListeners := map[string]rpc.Listener{
+			// TODO: Don't hardcode
+			"tickets.rpc.categoryupdate": listeners.NewTicketStatusUpdater(&pgCache, logger),
+		}
+
+		if config.Conf.Kafka.OrderedLanes > 0 {
+			// The RPC client handles messages concurrently, so events would not be submitted to the lanes in order
+			orderedConsumer, err := event.NewOrderedConsumer(
+				logger.With(zap.String("service", "gateway-events-kafka-ordered")),
+				config.Conf.Kafka.Brokers,
+				config.Conf.Kafka.OrderedConsumerGroup,
+				config.Conf.Kafka.EventsTopic,
+				kafkaListener,
+				config.Conf.Kafka.OrderedLanes,
+				config.Conf.Kafka.LaneBufferSize,
+			)
+			if err != nil {
+				logger.Fatal("Failed to create ordered event consumer", zap.Error(err))
+				return
+			}
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				orderedConsumer.Run(shutdownCtx)
+			}()
+		} else {
+			// Listen for gateway events over Kafka
+			rpcListeners[config.Conf.Kafka.EventsTopic] = kafkaListener
+		}
+
 		rpcClient, err := rpc.NewClient(
 			logger.With(zap.String("service", "rpc")),
 			rpc.Config{
@@ -144,15 +241,8 @@ func main() {
 				ConsumerGroup:       "worker",
 				ConsumerConcurrency: config.Conf.Kafka.GoroutineLimit,
 			},
-			map[string]rpc.Listener{
-				// Listen for gateway events over Kafka
-				config.Conf.Kafka.EventsTopic: event.NewKafkaListener(
-					logger.With(zap.String("service", "gateway-events-kafka")),
-					&pgCache,
-				),
-				// TODO: Don't hardcode
-				"tickets.rpc.categoryupdate": listeners.NewTicketStatusUpdater(&pgCache, logger),
-			})
+			rpcListeners,
+		)
 
 		if err != nil {
 			logger.Fatal("Failed to create RPC client", zap.Error(err))
@@ -165,34 +255,47 @@ func main() {
 			rpcClient.StartConsumer()
 		}()
 
-		shutdownCh := make(chan os.Signal, 1)
-		signal.Notify(shutdownCh, syscall.SIGINT, syscall.SIGTERM)
-		<-shutdownCh
+		<-shutdownCtx.Done()
 
 		logger.Info("Received shutdown signal")
 		rpcClient.Shutdown()
 
-		if waitTimeout(&wg, time.Second*10) {
+		if utils.WaitTimeout(&wg, config.Conf.ShutdownTimeout) {
 			logger.Info("Shutdown completed gracefully")
 		} else {
 			logger.Warn("Graceful shutdown timed out, exiting now")
 		}
+	} else if config.Conf.WorkerMode == config.WorkerModeStandalone {
+		logger.Info("Connecting to Discord gateway", zap.String("mode", string(config.Conf.WorkerMode)))
+
+		httpDone := make(chan struct{})
+		go func() {
+			defer close(httpDone)
+			event.HttpListen(shutdownCtx, redis.Client, &pgCache)
+		}()
+
+		gateway := event.NewStandaloneGateway(logger.With(zap.String("service", "gateway-standalone")), redis.Client, &pgCache)
+		gateway.Connect()
+
+		<-shutdownCtx.Done()
+
+		logger.Info("Received shutdown signal")
+		gateway.Shutdown()
+		<-httpDone
 	} else {
 		logger.Fatal("Invalid worker mode", zap.String("mode", string(config.Conf.WorkerMode)))
 	}
-}
 
-func waitTimeout(wg *sync.WaitGroup, timeout time.Duration) bool {
-	ch := make(chan struct{})
-	go func() {
-		defer close(ch)
-		wg.Wait()
-	}()
+	if messagequeue.WaitForInFlight(config.Conf.ShutdownTimeout) {
+		logger.Info("Message queue work completed")
+	} else {
+		logger.Warn("Timed out waiting for message queue work to complete")
+	}
+
+	flushCtx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
 
-	select {
-	case <-ch:
-		return true
-	case <-time.After(timeout):
-		return false
+	if err := shutdownTracing(flushCtx); err != nil {
+		logger.Warn("Failed to flush traces", zap.Error(err))
 	}
 }
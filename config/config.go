@@ -16,15 +16,20 @@ type (
 		JsonLogs  bool          `env:"WORKER_JSON_LOGS" envDefault:"false"`
 		LogLevel  zapcore.Level `env:"WORKER_LOG_LEVEL" envDefault:"info"`
 
-		WorkerMode WorkerMode `env:"WORKER_MODE"`
+		WorkerMode      WorkerMode    `env:"WORKER_MODE"`
+		ShutdownTimeout time.Duration `env:"WORKER_SHUTDOWN_TIMEOUT" envDefault:"30s"`
 
 		Discord struct {
 			Token            string        `env:"WORKER_PUBLIC_TOKEN"`
 			PublicBotId      uint64        `env:"WORKER_PUBLIC_ID"`
+			PublicKey        string        `env:"DISCORD_PUBLIC_KEY"`
 			ProxyUrl         string        `env:"DISCORD_PROXY_URL"`
 			RequestTimeout   time.Duration `env:"DISCORD_REQUEST_TIMEOUT" envDefault:"15s"`
 			CallbackTimeout  time.Duration `env:"DISCORD_CALLBACK_TIMEOUT" envDefault:"2000ms"`
 			DeferHardTimeout time.Duration `env:"DISCORD_DEFER_HARD_TIMEOUT" envDefault:"2500ms"`
+
+			// If set, interactions wrapped by the proxy, which are not signed by Discord, must carry this secret
+			ForwardedInteractionSecret string `env:"DISCORD_FORWARDED_INTERACTION_SECRET"`
 		}
 
 		Bot struct {
@@ -84,12 +89,43 @@ type (
 			Brokers        []string `env:"BROKERS"`
 			EventsTopic    string   `env:"EVENTS_TOPIC"`
 			GoroutineLimit int      `env:"GOROUTINE_LIMIT" envDefault:"1000"`
+
+			RetryAttempts   int           `env:"RETRY_ATTEMPTS" envDefault:"3"`
+			RetryBackoff    time.Duration `env:"RETRY_BACKOFF" envDefault:"500ms"`
+			DeadLetterTopic string        `env:"DEAD_LETTER_TOPIC"`
+
+			// Number of lanes used to process events from the same guild in order. 0 disables ordering.
+			OrderedLanes   int `env:"ORDERED_LANES" envDefault:"0"`
+			LaneBufferSize int `env:"LANE_BUFFER_SIZE" envDefault:"100"`
+
+			// Consumer group used for events when ordering is enabled. It must differ from the RPC consumer's group,
+			// which consumes other topics.
+			OrderedConsumerGroup string `env:"ORDERED_CONSUMER_GROUP" envDefault:"worker-events"`
 		} `envPrefix:"KAFKA_"`
 
+		Standalone struct {
+			ShardCount int `env:"SHARD_COUNT" envDefault:"1"`
+		} `envPrefix:"WORKER_STANDALONE_"`
+
+		Capture struct {
+			Path       string   `env:"PATH"`
+			SampleRate float64  `env:"SAMPLE_RATE" envDefault:"1"`
+			GuildIds   []uint64 `env:"GUILD_IDS"`
+		} `envPrefix:"WORKER_CAPTURE_"`
+
 		Prometheus struct {
 			Address string `env:"PROMETHEUS_SERVER_ADDR"`
 		}
 
+		Tracing struct {
+			OtlpEndpoint string  `env:"OTLP_ENDPOINT"`
+			OtlpInsecure bool    `env:"OTLP_INSECURE" envDefault:"false"`
+			SampleRate   float64 `env:"SAMPLE_RATE" envDefault:"1"`
+
+			// When no collector is configured, traces taking longer than this are logged. 0 disables tracing.
+			SlowThreshold time.Duration `env:"SLOW_THRESHOLD" envDefault:"0"`
+		} `envPrefix:"WORKER_TRACING_"`
+
 		Statsd struct {
 			Address string `env:"ADDR"`
 			Prefix  string `env:"PREFIX"`
@@ -109,6 +145,7 @@ var Conf Config
 const (
 	WorkerModeGateway      WorkerMode = "GATEWAY"
 	WorkerModeInteractions WorkerMode = "INTERACTIONS"
+	WorkerModeStandalone   WorkerMode = "STANDALONE"
 )
 
 func Parse() {
@@ -1,6 +1,8 @@
 package worker
 
 import (
+	"context"
+
 	"github.com/rxdn/gdl/cache"
 	"github.com/rxdn/gdl/objects/user"
 	"github.com/rxdn/gdl/rest/ratelimit"
@@ -13,6 +15,19 @@ type Context struct {
 	ShardId      int
 	Cache        *cache.PgCache
 	RateLimiter  *ratelimit.Ratelimiter
+
+	// TraceContext carries the span of the event or interaction being handled. May be nil.
+	TraceContext context.Context
+}
+
+// BaseContext returns a context carrying the trace of the event or interaction being handled, to be used in place of
+// context.Background(). It is never cancelled, as work is often carried on after the event has been handled.
+func (ctx *Context) BaseContext() context.Context {
+	if ctx.TraceContext == nil {
+		return context.Background()
+	}
+
+	return context.WithoutCancel(ctx.TraceContext)
 }
 
 func (ctx *Context) Self() (user.User, error) {
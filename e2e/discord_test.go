@@ -0,0 +1,446 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// restCall is a request made by the worker to the fake Discord API
+type restCall struct {
+	Method string
+	Path   string
+	Body   map[string]any
+}
+
+type routeHandler func(params []string, body map[string]any) (int, any)
+
+type route struct {
+	method  string
+	pattern *regexp.Regexp
+	handler routeHandler
+}
+
+// fakeDiscord is a stand-in for the Discord REST API, which keeps just enough state about a single guild for tickets to
+// be opened, claimed and closed. Every request is recorded, so that scenarios can assert on what the worker sent.
+type fakeDiscord struct {
+	server *httptest.Server
+	routes []route
+
+	mu       sync.Mutex
+	calls    []restCall
+	channels map[uint64]map[string]any
+	messages map[uint64]map[string]any
+
+	guildId   uint64
+	ownerId   uint64
+	botId     uint64
+	botRoleId uint64
+}
+
+// archiverPath is the path that the archiver client is pointed at, which accepts any transcript
+const archiverPath = "/archiver"
+
+var apiVersionPrefix = regexp.MustCompile(`^/api/v\d+`)
+
+func newFakeDiscord(guildId, ownerId, botId uint64) *fakeDiscord {
+	d := &fakeDiscord{
+		channels:  make(map[uint64]map[string]any),
+		messages:  make(map[uint64]map[string]any),
+		guildId:   guildId,
+		ownerId:   ownerId,
+		botId:     botId,
+		botRoleId: nextSnowflake(),
+	}
+
+	d.registerRoutes()
+	d.server = httptest.NewServer(d)
+
+	return d
+}
+
+func (d *fakeDiscord) Close() {
+	d.server.Close()
+}
+
+// Addr returns the host:port of the fake API, to be used as the proxy URL
+func (d *fakeDiscord) Addr() string {
+	return d.server.Listener.Addr().String()
+}
+
+func (d *fakeDiscord) URL() string {
+	return d.server.URL
+}
+
+// Handle registers a route, taking priority over those already registered. Path parameters are written as {}.
+func (d *fakeDiscord) Handle(method, path string, handler routeHandler) {
+	pattern := "^" + strings.ReplaceAll(regexp.QuoteMeta(path), `\{\}`, `([^/]+)`) + "$"
+
+	d.routes = append([]route{{
+		method:  method,
+		pattern: regexp.MustCompile(pattern),
+		handler: handler,
+	}}, d.routes...)
+}
+
+func (d *fakeDiscord) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	path := apiVersionPrefix.ReplaceAllString(r.URL.Path, "")
+
+	var body map[string]any
+	if raw, _ := io.ReadAll(r.Body); len(raw) > 0 {
+		_ = json.Unmarshal(raw, &body) // Multipart bodies are recorded without a body
+	}
+
+	d.mu.Lock()
+	d.calls = append(d.calls, restCall{
+		Method: r.Method,
+		Path:   path,
+		Body:   body,
+	})
+	d.mu.Unlock()
+
+	status, res := http.StatusNotFound, any(map[string]any{"message": "Unknown route", "code": 0})
+	if strings.HasPrefix(path, archiverPath) {
+		status, res = http.StatusOK, map[string]any{}
+	}
+
+	for _, route := range d.routes {
+		if route.method != r.Method {
+			continue
+		}
+
+		if groups := route.pattern.FindStringSubmatch(path); groups != nil {
+			status, res = route.handler(groups[1:], body)
+			break
+		}
+	}
+
+	if status == http.StatusNoContent {
+		w.WriteHeader(status)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(res)
+}
+
+// Calls returns the requests matching the method and path, or all requests if both are empty
+func (d *fakeDiscord) Calls(method, path string) []restCall {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var calls []restCall
+	for _, call := range d.calls {
+		if (method == "" || call.Method == method) && (path == "" || call.Path == path) {
+			calls = append(calls, call)
+		}
+	}
+
+	return calls
+}
+
+// RequireCall fails the test if no request was made with the method and path, returning the most recent one otherwise
+func (d *fakeDiscord) RequireCall(t *testing.T, method, path string) restCall {
+	t.Helper()
+
+	calls := d.Calls(method, path)
+	if len(calls) == 0 {
+		t.Fatalf("expected %s %s, got: %s", method, path, d.describeCalls())
+	}
+
+	return calls[len(calls)-1]
+}
+
+func (d *fakeDiscord) ResetCalls() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.calls = nil
+}
+
+func (d *fakeDiscord) Channel(id uint64) (map[string]any, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	channel, ok := d.channels[id]
+	return channel, ok
+}
+
+func (d *fakeDiscord) Message(id uint64) (map[string]any, bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	msg, ok := d.messages[id]
+	return msg, ok
+}
+
+// AddChannel adds a text channel to the guild, such as one that a panel is sent in
+func (d *fakeDiscord) AddChannel(name string) uint64 {
+	id := nextSnowflake()
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.channels[id] = map[string]any{
+		"id":       snowflake(id),
+		"guild_id": snowflake(d.guildId),
+		"type":     0,
+		"name":     name,
+	}
+
+	return id
+}
+
+func (d *fakeDiscord) describeCalls() string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	lines := make([]string, len(d.calls))
+	for i, call := range d.calls {
+		lines[i] = fmt.Sprintf("%s %s", call.Method, call.Path)
+	}
+
+	return "[" + strings.Join(lines, ", ") + "]"
+}
+
+func (d *fakeDiscord) registerRoutes() {
+	ok := func(res any) (int, any) { return http.StatusOK, res }
+	noContent := func() (int, any) { return http.StatusNoContent, nil }
+	notFound := func() (int, any) {
+		return http.StatusNotFound, map[string]any{"message": "Unknown", "code": 10003}
+	}
+
+	// Users
+	d.Handle("GET", "/users/{}", func(params []string, _ map[string]any) (int, any) {
+		return ok(d.user(parseSnowflake(params[0])))
+	})
+	d.Handle("GET", "/users/@me", func(_ []string, _ map[string]any) (int, any) {
+		return ok(d.user(d.botId))
+	})
+	d.Handle("POST", "/users/@me/channels", func(_ []string, body map[string]any) (int, any) {
+		id := nextSnowflake()
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		d.channels[id] = map[string]any{
+			"id":         snowflake(id),
+			"type":       1,
+			"recipients": []any{d.user(parseSnowflake(fmt.Sprint(body["recipient_id"])))},
+		}
+
+		return ok(d.channels[id])
+	})
+
+	// Guild
+	d.Handle("GET", "/guilds/{}", func(_ []string, _ map[string]any) (int, any) {
+		return ok(map[string]any{
+			"id":       snowflake(d.guildId),
+			"name":     "Test Guild",
+			"owner_id": snowflake(d.ownerId),
+			"roles":    d.roles(),
+		})
+	})
+	d.Handle("GET", "/guilds/{}/roles", func(_ []string, _ map[string]any) (int, any) {
+		return ok(d.roles())
+	})
+	d.Handle("GET", "/guilds/{}/members/{}", func(params []string, _ map[string]any) (int, any) {
+		return ok(d.member(parseSnowflake(params[1])))
+	})
+	d.Handle("GET", "/guilds/{}/channels", func(_ []string, _ map[string]any) (int, any) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		channels := make([]map[string]any, 0, len(d.channels))
+		for _, channel := range d.channels {
+			if channel["guild_id"] != nil {
+				channels = append(channels, channel)
+			}
+		}
+
+		return ok(channels)
+	})
+	d.Handle("POST", "/guilds/{}/channels", func(_ []string, body map[string]any) (int, any) {
+		id := nextSnowflake()
+
+		channel := map[string]any{"type": 0}
+		for key, value := range body {
+			channel[key] = value
+		}
+
+		channel["id"] = snowflake(id)
+		channel["guild_id"] = snowflake(d.guildId)
+
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		d.channels[id] = channel
+		return ok(channel)
+	})
+
+	// Channels
+	d.Handle("GET", "/channels/{}", func(params []string, _ map[string]any) (int, any) {
+		if channel, exists := d.Channel(parseSnowflake(params[0])); exists {
+			return ok(channel)
+		}
+
+		return notFound()
+	})
+	d.Handle("PATCH", "/channels/{}", func(params []string, body map[string]any) (int, any) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		channel, exists := d.channels[parseSnowflake(params[0])]
+		if !exists {
+			return notFound()
+		}
+
+		for key, value := range body {
+			channel[key] = value
+		}
+
+		return ok(channel)
+	})
+	d.Handle("DELETE", "/channels/{}", func(params []string, _ map[string]any) (int, any) {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		id := parseSnowflake(params[0])
+		channel, exists := d.channels[id]
+		if !exists {
+			return notFound()
+		}
+
+		delete(d.channels, id)
+		return ok(channel)
+	})
+	d.Handle("PUT", "/channels/{}/permissions/{}", func(_ []string, _ map[string]any) (int, any) {
+		return noContent()
+	})
+	d.Handle("POST", "/channels/{}/webhooks", func(params []string, _ map[string]any) (int, any) {
+		return ok(map[string]any{
+			"id":         snowflake(nextSnowflake()),
+			"channel_id": params[0],
+			"token":      "webhook-token",
+		})
+	})
+
+	// Messages
+	d.Handle("GET", "/channels/{}/messages", func(_ []string, _ map[string]any) (int, any) {
+		return ok([]any{})
+	})
+	d.Handle("POST", "/channels/{}/messages", func(params []string, body map[string]any) (int, any) {
+		return ok(d.storeMessage(parseSnowflake(params[0]), body))
+	})
+	d.Handle("PATCH", "/channels/{}/messages/{}", func(params []string, body map[string]any) (int, any) {
+		return ok(d.editMessage(parseSnowflake(params[0]), parseSnowflake(params[1]), body))
+	})
+	d.Handle("DELETE", "/channels/{}/messages/{}", func(_ []string, _ map[string]any) (int, any) {
+		return noContent()
+	})
+
+	// Interaction responses
+	d.Handle("POST", "/interactions/{}/{}/callback", func(_ []string, _ map[string]any) (int, any) {
+		return noContent()
+	})
+	d.Handle("POST", "/webhooks/{}/{}", func(_ []string, body map[string]any) (int, any) {
+		return ok(d.storeMessage(0, body))
+	})
+	d.Handle("PATCH", "/webhooks/{}/{}/messages/{}", func(_ []string, body map[string]any) (int, any) {
+		return ok(d.storeMessage(0, body))
+	})
+}
+
+func (d *fakeDiscord) storeMessage(channelId uint64, body map[string]any) map[string]any {
+	msg := map[string]any{}
+	for key, value := range body {
+		msg[key] = value
+	}
+
+	id := nextSnowflake()
+	msg["id"] = snowflake(id)
+	msg["channel_id"] = snowflake(channelId)
+	msg["author"] = d.user(d.botId)
+	msg["timestamp"] = time.Now().Format(time.RFC3339)
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.messages[id] = msg
+	return msg
+}
+
+func (d *fakeDiscord) editMessage(channelId, messageId uint64, body map[string]any) map[string]any {
+	d.mu.Lock()
+	if msg, exists := d.messages[messageId]; exists {
+		defer d.mu.Unlock()
+
+		for key, value := range body {
+			msg[key] = value
+		}
+
+		return msg
+	}
+	d.mu.Unlock()
+
+	return d.storeMessage(channelId, body)
+}
+
+func (d *fakeDiscord) user(id uint64) map[string]any {
+	return map[string]any{
+		"id":       snowflake(id),
+		"username": fmt.Sprintf("user%d", id),
+		"bot":      id == d.botId,
+	}
+}
+
+func (d *fakeDiscord) member(userId uint64) map[string]any {
+	roles := []string{}
+	if userId == d.botId {
+		roles = append(roles, snowflake(d.botRoleId))
+	}
+
+	return map[string]any{
+		"user":      d.user(userId),
+		"roles":     roles,
+		"joined_at": time.Now().Format(time.RFC3339),
+	}
+}
+
+// roles returns the @everyone role, and the bot's integration role, which has administrator
+func (d *fakeDiscord) roles() []map[string]any {
+	return []map[string]any{
+		{
+			"id":          snowflake(d.guildId),
+			"name":        "@everyone",
+			"permissions": "0",
+		},
+		{
+			"id":          snowflake(d.botRoleId),
+			"name":        "Tickets",
+			"permissions": "8",
+			"managed":     true,
+			"tags": map[string]any{
+				"bot_id": snowflake(d.botId),
+			},
+		},
+	}
+}
+
+func snowflake(id uint64) string {
+	return strconv.FormatUint(id, 10)
+}
+
+func parseSnowflake(s string) uint64 {
+	id, _ := strconv.ParseUint(s, 10, 64)
+	return id
+}
@@ -0,0 +1,5 @@
+// Package e2e contains end-to-end tests, which feed interactions and gateway events through the same handlers used in
+// production. Requests to Discord are sent to a fake API, Redis is replaced by miniredis, and the database and cache
+// are stored in a disposable Postgres server, started by the tests with the schema applied. To use existing databases
+// instead, point DATABASE_* and CACHE_* at them.
+package e2e
@@ -0,0 +1,394 @@
+package e2e
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	archiverclient "github.com/jadevelopmentgrp/Tickets-Archiver-Client"
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/eventforwarding"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/cache"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"github.com/jadevelopmentgrp/Tickets-Worker/event"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/gateway/payloads"
+	"github.com/rxdn/gdl/gateway/payloads/events"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/rxdn/gdl/objects/interaction/component"
+	"github.com/rxdn/gdl/rest/request"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+const discordEpoch = 1420070400000
+
+var (
+	snowflakeIncrement atomic.Uint64
+
+	// The worker's clients are package level, so the environment is shared between all tests
+	env *environment
+)
+
+type environment struct {
+	discord  *fakeDiscord
+	replayer *event.Replayer
+
+	guildId uint64
+	ownerId uint64
+	botId   uint64
+}
+
+type harness struct {
+	*environment
+	t   *testing.T
+	ctx context.Context
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(run(m))
+}
+
+func run(m *testing.M) int {
+	config.Parse()
+
+	// Use a disposable Postgres server, unless existing databases have been configured
+	var postgres *embeddedPostgres
+	if config.Conf.Database.Host == "" || config.Conf.Cache.Host == "" {
+		var err error
+		if postgres, err = startPostgres(); err != nil {
+			panic(err)
+		}
+
+		defer postgres.Stop()
+	}
+
+	// Locales are loaded relative to the repository root
+	if err := os.Chdir(".."); err != nil {
+		panic(err)
+	}
+
+	mr, err := miniredis.Run()
+	if err != nil {
+		panic(err)
+	}
+	defer mr.Close()
+
+	guildId, ownerId, botId := nextSnowflake(), nextSnowflake(), nextSnowflake()
+
+	discord := newFakeDiscord(guildId, ownerId, botId)
+	defer discord.Close()
+
+	config.Conf.Discord.Token = "test-token"
+	config.Conf.Discord.PublicBotId = botId
+	config.Conf.Discord.PublicKey = ""
+	config.Conf.Discord.ForwardedInteractionSecret = ""
+	config.Conf.Discord.ProxyUrl = discord.Addr()
+	config.Conf.Archiver.Url = discord.URL() + archiverPath
+	config.Conf.Redis.Address = mr.Addr()
+	config.Conf.Database.Threads = max(config.Conf.Database.Threads, 1)
+	config.Conf.Cache.Threads = max(config.Conf.Cache.Threads, 1)
+
+	if config.Conf.Archiver.AesKey == "" {
+		config.Conf.Archiver.AesKey = "00000000000000000000000000000000"
+	}
+
+	// The proxy hook lets application routes go straight to Discord, so redirect those too
+	request.RegisterPreRequestHook(utils.ProxyHook)
+	request.RegisterPreRequestHook(func(token string, req *http.Request) {
+		req.URL.Scheme = "http"
+		req.URL.Host = discord.Addr()
+	})
+
+	if err := redis.Connect(); err != nil {
+		panic(err)
+	}
+
+	dbclient.Connect()
+	i18n.Init()
+
+	pgCache, err := cache.Connect(zap.NewNop())
+	if err != nil {
+		panic(err)
+	}
+
+	cache.Client = &pgCache
+
+	if postgres != nil {
+		if err := createSchema(context.Background(), &pgCache); err != nil {
+			panic(err)
+		}
+	}
+
+	utils.ArchiverClient = archiverclient.NewArchiverClient(
+		archiverclient.NewProxyRetriever(config.Conf.Archiver.Url),
+		[]byte(config.Conf.Archiver.AesKey),
+	)
+
+	if err := dbclient.Client.FeedbackEnabled.Set(context.Background(), guildId, true); err != nil {
+		panic(err)
+	}
+
+	env = &environment{
+		discord:  discord,
+		replayer: event.NewReplayer(redis.Client, &pgCache),
+		guildId:  guildId,
+		ownerId:  ownerId,
+		botId:    botId,
+	}
+
+	return m.Run()
+}
+
+func newHarness(t *testing.T) *harness {
+	env.discord.ResetCalls()
+
+	return &harness{
+		environment: env,
+		t:           t,
+		ctx:         context.Background(),
+	}
+}
+
+// nextSnowflake generates a unique ID, with the current time encoded so that interactions are not treated as expired
+func nextSnowflake() uint64 {
+	return uint64(time.Now().UnixMilli()-discordEpoch)<<22 | snowflakeIncrement.Add(1)&0x3fffff
+}
+
+// createPanel creates a panel in the guild, with a single question form if withForm is set. Returns the panel, and
+// the custom ID of the form input.
+func (h *harness) createPanel(withForm bool) (database.Panel, string) {
+	suffix := nextSnowflake()
+
+	panel := database.Panel{
+		ChannelId:       h.discord.AddChannel("panels"),
+		GuildId:         h.guildId,
+		Title:           "Support",
+		Content:         "Click the button below to open a ticket",
+		Colour:          0x2ecc71,
+		WithDefaultTeam: true,
+		CustomId:        fmt.Sprintf("panel-%d", suffix),
+		ButtonStyle:     int(component.ButtonStylePrimary),
+		ButtonLabel:     "Open a ticket",
+	}
+
+	var inputCustomId string
+	if withForm {
+		formId, err := dbclient.Client.Forms.Create(h.ctx, h.guildId, "Tell us more", fmt.Sprintf("form-%d", suffix))
+		require.NoError(h.t, err)
+
+		inputCustomId = fmt.Sprintf("subject-%d", suffix)
+		_, err = dbclient.Client.FormInput.Create(h.ctx, formId, inputCustomId, 0, uint8(component.TextStyleShort), "Subject", nil, true, nil, utils.Ptr(uint16(100)))
+		require.NoError(h.t, err)
+
+		panel.FormId = &formId
+	}
+
+	panelId, err := dbclient.Client.Panel.Create(h.ctx, panel)
+	require.NoError(h.t, err)
+
+	panel.PanelId = panelId
+	return panel, inputCustomId
+}
+
+// runCommand runs a slash command with the given top level options, returning the initial response
+func (h *harness) runCommand(userId, channelId uint64, name string, options map[string]any) map[string]any {
+	data := map[string]any{
+		"id":   snowflake(nextSnowflake()),
+		"name": name,
+		"type": interaction.ApplicationCommandTypeChatInput,
+	}
+
+	var commandOptions []map[string]any
+	for optionName, value := range options {
+		commandOptions = append(commandOptions, map[string]any{
+			"name":  optionName,
+			"type":  interaction.OptionTypeString,
+			"value": value,
+		})
+	}
+
+	data["options"] = commandOptions
+
+	return h.sendInteraction(interaction.InteractionTypeApplicationCommand, userId, channelId, data, nil)
+}
+
+// clickButton clicks a button on the message, returning the initial response
+func (h *harness) clickButton(userId, channelId uint64, customId string, message map[string]any) map[string]any {
+	data := map[string]any{
+		"custom_id":      customId,
+		"component_type": component.ComponentButton,
+	}
+
+	if message == nil {
+		message = map[string]any{
+			"id":         snowflake(nextSnowflake()),
+			"channel_id": snowflake(channelId),
+			"author":     h.discord.user(h.botId),
+		}
+	}
+
+	return h.sendInteraction(interaction.InteractionTypeMessageComponent, userId, channelId, data, message)
+}
+
+// submitModal submits a modal with a text input for each value
+func (h *harness) submitModal(userId, channelId uint64, customId string, values map[string]string) map[string]any {
+	var rows []map[string]any
+	for inputCustomId, value := range values {
+		rows = append(rows, map[string]any{
+			"type": component.ComponentActionRow,
+			"components": []map[string]any{
+				{
+					"type":      component.ComponentInputText,
+					"custom_id": inputCustomId,
+					"value":     value,
+				},
+			},
+		})
+	}
+
+	data := map[string]any{
+		"custom_id":  customId,
+		"components": rows,
+	}
+
+	return h.sendInteraction(interaction.InteractionTypeModalSubmit, userId, channelId, data, nil)
+}
+
+// sendInteraction sends an interaction from the user, and waits for the worker to finish handling it. Interactions in
+// DM channels are sent without a guild. Returns the initial response.
+func (h *harness) sendInteraction(
+	interactionType interaction.InteractionType,
+	userId, channelId uint64,
+	data any,
+	message map[string]any,
+) map[string]any {
+	h.t.Helper()
+
+	payload := map[string]any{
+		"version":         1,
+		"id":              snowflake(nextSnowflake()),
+		"application_id":  snowflake(h.botId),
+		"type":            interactionType,
+		"token":           fmt.Sprintf("interaction-token-%d", nextSnowflake()),
+		"channel_id":      snowflake(channelId),
+		"app_permissions": "8",
+		"locale":          "en-GB",
+		"data":            data,
+	}
+
+	if channel, ok := h.discord.Channel(channelId); ok && channel["type"] == 1 {
+		payload["user"] = h.discord.user(userId)
+	} else {
+		payload["guild_id"] = snowflake(h.guildId)
+		payload["guild_locale"] = "en-GB"
+		payload["member"] = h.discord.member(userId)
+	}
+
+	if message != nil {
+		payload["message"] = message
+	}
+
+	marshalled, err := json.Marshal(payload)
+	require.NoError(h.t, err)
+
+	wrapped, err := json.Marshal(eventforwarding.Interaction{
+		BotToken:        config.Conf.Discord.Token,
+		BotId:           h.botId,
+		IsWhitelabel:    false,
+		InteractionType: interactionType,
+		Event:           marshalled,
+	})
+	require.NoError(h.t, err)
+
+	status, body, err := h.replayer.Replay(event.CapturedPayload{
+		Kind:       event.CaptureKindInteraction,
+		CapturedAt: time.Now(),
+		Payload:    wrapped,
+	})
+	require.NoError(h.t, err)
+	require.Equal(h.t, http.StatusOK, status, string(body))
+
+	var res map[string]any
+	require.NoError(h.t, json.Unmarshal(body, &res))
+
+	return res
+}
+
+// sendMessage sends a MESSAGE_CREATE event for a message sent by the user
+func (h *harness) sendMessage(userId, channelId uint64, content string) {
+	h.t.Helper()
+
+	member := h.discord.member(userId)
+	delete(member, "user")
+
+	h.sendEvent(events.MESSAGE_CREATE, map[string]any{
+		"id":         snowflake(nextSnowflake()),
+		"channel_id": snowflake(channelId),
+		"guild_id":   snowflake(h.guildId),
+		"author":     h.discord.user(userId),
+		"member":     member,
+		"content":    content,
+		"timestamp":  time.Now().Format(time.RFC3339),
+	})
+}
+
+func (h *harness) sendEvent(eventType events.EventType, data any) {
+	h.t.Helper()
+
+	marshalled, err := json.Marshal(data)
+	require.NoError(h.t, err)
+
+	payload, err := json.Marshal(payloads.Payload{
+		Opcode:    0, // Dispatch
+		Data:      marshalled,
+		EventName: string(eventType),
+	})
+	require.NoError(h.t, err)
+
+	wrapped, err := json.Marshal(eventforwarding.Event{
+		BotToken:     config.Conf.Discord.Token,
+		BotId:        h.botId,
+		IsWhitelabel: false,
+		ShardId:      0,
+		Event:        payload,
+	})
+	require.NoError(h.t, err)
+
+	status, body, err := h.replayer.Replay(event.CapturedPayload{
+		Kind:       event.CaptureKindEvent,
+		CapturedAt: time.Now(),
+		Payload:    wrapped,
+	})
+	require.NoError(h.t, err)
+	require.Equal(h.t, http.StatusOK, status, string(body))
+}
+
+// findMessage returns the channel ID and body of the most recent message sent by the worker whose body contains s
+func (h *harness) findMessage(s string) (uint64, map[string]any, bool) {
+	calls := h.discord.Calls("POST", "")
+	for i := len(calls) - 1; i >= 0; i-- {
+		call := calls[i]
+		if !strings.HasPrefix(call.Path, "/channels/") || !strings.HasSuffix(call.Path, "/messages") {
+			continue
+		}
+
+		marshalled, _ := json.Marshal(call.Body)
+		if strings.Contains(string(marshalled), s) {
+			channelId := parseSnowflake(strings.TrimSuffix(strings.TrimPrefix(call.Path, "/channels/"), "/messages"))
+			return channelId, call.Body, true
+		}
+	}
+
+	return 0, nil, false
+}
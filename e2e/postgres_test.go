@@ -0,0 +1,128 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+
+	embeddedpostgres "github.com/fergusstrange/embedded-postgres"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"github.com/rxdn/gdl/cache"
+)
+
+const (
+	postgresUsername = "postgres"
+	postgresPassword = "postgres"
+	databaseName     = "tickets"
+	cacheName        = "cache"
+)
+
+// embeddedPostgres is a disposable Postgres server, which holds both the database and the cache
+type embeddedPostgres struct {
+	server      *embeddedpostgres.EmbeddedPostgres
+	runtimePath string
+}
+
+// startPostgres starts a Postgres server, with an empty database for the worker and one for the cache, and points the
+// config at them. The server binaries are downloaded on first use, and cached.
+func startPostgres() (*embeddedPostgres, error) {
+	port, err := freePort()
+	if err != nil {
+		return nil, err
+	}
+
+	runtimePath, err := os.MkdirTemp("", "worker-e2e-postgres")
+	if err != nil {
+		return nil, err
+	}
+
+	server := embeddedpostgres.NewDatabase(embeddedpostgres.DefaultConfig().
+		Port(port).
+		Username(postgresUsername).
+		Password(postgresPassword).
+		Database(databaseName).
+		RuntimePath(runtimePath).
+		Logger(io.Discard))
+
+	if err := server.Start(); err != nil {
+		_ = os.RemoveAll(runtimePath)
+		return nil, err
+	}
+
+	postgres := &embeddedPostgres{
+		server:      server,
+		runtimePath: runtimePath,
+	}
+
+	host := fmt.Sprintf("localhost:%d", port)
+	if err := createDatabase(host, cacheName); err != nil {
+		postgres.Stop()
+		return nil, err
+	}
+
+	config.Conf.Database.Host = host
+	config.Conf.Database.Database = databaseName
+	config.Conf.Database.Username = postgresUsername
+	config.Conf.Database.Password = postgresPassword
+
+	config.Conf.Cache.Host = host
+	config.Conf.Cache.Database = cacheName
+	config.Conf.Cache.Username = postgresUsername
+	config.Conf.Cache.Password = postgresPassword
+
+	return postgres, nil
+}
+
+func (p *embeddedPostgres) Stop() {
+	if err := p.server.Stop(); err != nil {
+		fmt.Printf("Failed to stop Postgres: %v\n", err)
+	}
+
+	_ = os.RemoveAll(p.runtimePath)
+}
+
+// createSchema creates the tables of the database and the cache, which are otherwise created by their migrations
+func createSchema(ctx context.Context, pgCache *cache.PgCache) error {
+	pool, err := pgxpool.Connect(ctx, postgresUri(config.Conf.Database.Host, databaseName))
+	if err != nil {
+		return err
+	}
+
+	defer pool.Close()
+
+	database.NewDatabase(pool).CreateTables(ctx, pool)
+
+	return pgCache.CreateSchema(ctx)
+}
+
+func createDatabase(host, name string) error {
+	conn, err := pgx.Connect(context.Background(), postgresUri(host, databaseName))
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close(context.Background())
+
+	_, err = conn.Exec(context.Background(), fmt.Sprintf(`CREATE DATABASE "%s";`, name))
+	return err
+}
+
+func postgresUri(host, database string) string {
+	return fmt.Sprintf("postgres://%s:%s@%s/%s", postgresUsername, postgresPassword, host, database)
+}
+
+func freePort() (uint32, error) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	if err != nil {
+		return 0, err
+	}
+
+	defer listener.Close()
+
+	return uint32(listener.Addr().(*net.TCPAddr).Port), nil
+}
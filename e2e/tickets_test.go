@@ -0,0 +1,230 @@
+package e2e
+
+import (
+	"fmt"
+	"testing"
+
+	database "github.com/jadevelopmentgrp/Tickets-Database"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/stretchr/testify/require"
+)
+
+type actor int
+
+const (
+	opener actor = iota
+	staff
+)
+
+// ticketState is carried between the steps of a scenario
+type ticketState struct {
+	panel          database.Panel
+	formInputId    string
+	openerId       uint64
+	staffId        uint64
+	ticket         database.Ticket
+	welcomeMessage map[string]any
+	dmChannelId    uint64
+}
+
+func (s *ticketState) userId(a actor) uint64 {
+	if a == staff {
+		return s.staffId
+	}
+
+	return s.openerId
+}
+
+type step func(t *testing.T, h *harness, s *ticketState)
+
+func TestTicketLifecycle(t *testing.T) {
+	scenarios := []struct {
+		name     string
+		withForm bool
+		steps    []step
+	}{
+		{
+			name:     "open from panel with form, claim, close with reason, rate",
+			withForm: true,
+			steps: []step{
+				openFromPanel("My order hasn't arrived"),
+				sendMessage(opener, "Any update?"),
+				claim(staff),
+				closeWithReason(staff, "Resolved"),
+				rate(opener, 5),
+			},
+		},
+		{
+			name: "open from panel, close with command",
+			steps: []step{
+				openFromPanel(""),
+				closeWithCommand(opener, "Opened by mistake"),
+			},
+		},
+		{
+			name: "only staff can claim",
+			steps: []step{
+				openFromPanel(""),
+				claimDenied(opener),
+			},
+		},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			h := newHarness(t)
+
+			state := &ticketState{
+				openerId: nextSnowflake(),
+				staffId:  h.ownerId,
+			}
+
+			state.panel, state.formInputId = h.createPanel(scenario.withForm)
+
+			for _, step := range scenario.steps {
+				h.discord.ResetCalls()
+				step(t, h, state)
+			}
+		})
+	}
+}
+
+func openFromPanel(subject string) step {
+	return func(t *testing.T, h *harness, s *ticketState) {
+		res := h.clickButton(s.openerId, s.panel.ChannelId, s.panel.CustomId, nil)
+
+		if s.panel.FormId != nil {
+			requireModal(t, res, "form_"+s.panel.CustomId)
+			h.submitModal(s.openerId, s.panel.ChannelId, "form_"+s.panel.CustomId, map[string]string{
+				s.formInputId: subject,
+			})
+		}
+
+		h.discord.RequireCall(t, "POST", fmt.Sprintf("/guilds/%d/channels", h.guildId))
+
+		tickets, err := dbclient.Client.Tickets.GetOpenByUser(h.ctx, h.guildId, s.openerId)
+		require.NoError(t, err)
+		require.Len(t, tickets, 1)
+
+		s.ticket = tickets[0]
+		require.NotNil(t, s.ticket.ChannelId)
+		require.Equal(t, s.panel.PanelId, *s.ticket.PanelId)
+
+		_, exists := h.discord.Channel(*s.ticket.ChannelId)
+		require.True(t, exists, "ticket channel was not created")
+
+		welcome := h.discord.RequireCall(t, "POST", fmt.Sprintf("/channels/%d/messages", *s.ticket.ChannelId))
+		if subject != "" {
+			require.Contains(t, fmt.Sprint(welcome.Body), subject, "form answer missing from welcome message")
+		}
+
+		require.NotNil(t, s.ticket.WelcomeMessageId)
+		s.welcomeMessage, exists = h.discord.Message(*s.ticket.WelcomeMessageId)
+		require.True(t, exists)
+	}
+}
+
+func sendMessage(a actor, content string) step {
+	return func(t *testing.T, h *harness, s *ticketState) {
+		h.sendMessage(s.userId(a), *s.ticket.ChannelId, content)
+
+		participated, err := dbclient.Client.Participants.HasParticipated(h.ctx, h.guildId, s.ticket.Id, s.userId(a))
+		require.NoError(t, err)
+		require.True(t, participated)
+	}
+}
+
+func claim(a actor) step {
+	return func(t *testing.T, h *harness, s *ticketState) {
+		h.clickButton(s.userId(a), *s.ticket.ChannelId, "claim", s.welcomeMessage)
+
+		h.discord.RequireCall(t, "PATCH", fmt.Sprintf("/channels/%d", *s.ticket.ChannelId))
+
+		claimedBy, err := dbclient.Client.TicketClaims.Get(h.ctx, h.guildId, s.ticket.Id)
+		require.NoError(t, err)
+		require.Equal(t, s.userId(a), claimedBy)
+	}
+}
+
+func claimDenied(a actor) step {
+	return func(t *testing.T, h *harness, s *ticketState) {
+		h.clickButton(s.userId(a), *s.ticket.ChannelId, "claim", s.welcomeMessage)
+
+		require.Empty(t, h.discord.Calls("PATCH", fmt.Sprintf("/channels/%d", *s.ticket.ChannelId)))
+
+		claimedBy, err := dbclient.Client.TicketClaims.Get(h.ctx, h.guildId, s.ticket.Id)
+		require.NoError(t, err)
+		require.Zero(t, claimedBy)
+	}
+}
+
+func closeWithReason(a actor, reason string) step {
+	return func(t *testing.T, h *harness, s *ticketState) {
+		res := h.clickButton(s.userId(a), *s.ticket.ChannelId, "close_with_reason", s.welcomeMessage)
+		requireModal(t, res, "close_with_reason_submit")
+
+		h.submitModal(s.userId(a), *s.ticket.ChannelId, "close_with_reason_submit", map[string]string{
+			"reason": reason,
+		})
+
+		requireClosed(t, h, s, a, reason)
+
+		// The opener is sent the close embed, with buttons to rate the ticket as they have sent a message
+		rateCustomId := fmt.Sprintf("rate_%d_%d_", h.guildId, s.ticket.Id)
+		dmChannelId, _, found := h.findMessage(rateCustomId)
+		require.True(t, found, "rating buttons were not sent to the opener")
+
+		s.dmChannelId = dmChannelId
+	}
+}
+
+func closeWithCommand(a actor, reason string) step {
+	return func(t *testing.T, h *harness, s *ticketState) {
+		h.runCommand(s.userId(a), *s.ticket.ChannelId, "close", map[string]any{
+			"reason": reason,
+		})
+
+		requireClosed(t, h, s, a, reason)
+	}
+}
+
+func rate(a actor, rating int) step {
+	return func(t *testing.T, h *harness, s *ticketState) {
+		customId := fmt.Sprintf("rate_%d_%d_%d", h.guildId, s.ticket.Id, rating)
+		h.clickButton(s.userId(a), s.dmChannelId, customId, nil)
+
+		stored, ok, err := dbclient.Client.ServiceRatings.Get(h.ctx, h.guildId, s.ticket.Id)
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.EqualValues(t, rating, stored)
+	}
+}
+
+func requireClosed(t *testing.T, h *harness, s *ticketState, closedBy actor, reason string) {
+	t.Helper()
+
+	h.discord.RequireCall(t, "DELETE", fmt.Sprintf("/channels/%d", *s.ticket.ChannelId))
+
+	ticket, err := dbclient.Client.Tickets.Get(h.ctx, s.ticket.Id, h.guildId)
+	require.NoError(t, err)
+	require.False(t, ticket.Open)
+
+	metadata, ok, err := dbclient.Client.CloseReason.Get(h.ctx, h.guildId, s.ticket.Id)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.NotNil(t, metadata.Reason)
+	require.Equal(t, reason, *metadata.Reason)
+	require.NotNil(t, metadata.ClosedBy)
+	require.Equal(t, s.userId(closedBy), *metadata.ClosedBy)
+}
+
+func requireModal(t *testing.T, res map[string]any, customId string) {
+	t.Helper()
+
+	require.EqualValues(t, interaction.ResponseTypeModal, res["type"])
+
+	data, ok := res["data"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, customId, data["custom_id"])
+}
@@ -31,8 +31,20 @@ func callCommand(
 
         v.Execute(ctx)
     case general.HelpCommand:
+        var arg0 *string
 
-        v.Execute(ctx)
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = &argValue
+        }
+
+        v.Execute(ctx, arg0)
     case general.JumpToTopCommand:
 
         v.Execute(ctx)
@@ -154,6 +166,24 @@ func callCommand(
     case settings.ViewStaffCommand:
 
         v.Execute(ctx)
+    case setup.AutoAssignSetupCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if err := cmd.Properties().Arguments[0].Validate(opt0.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
+        v.Execute(ctx, arg0)
     case setup.AutoSetupCommand:
 
         v.Execute(ctx)
@@ -172,6 +202,225 @@ func callCommand(
         }
 
         v.Execute(ctx, arg0)
+    case setup.OnHoldCategorySetupCommand:
+        var arg0 *uint64
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else {
+            if err := cmd.Properties().Arguments[0].Validate(opt0.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            }
+            raw, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt0.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt0.Name)
+            }
+            arg0 = &argValue
+        }
+
+        v.Execute(ctx, arg0)
+    case setup.PanelPrioritySetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 string
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            return ErrArgumentNotFound
+        } else {
+            if err := cmd.Properties().Arguments[1].Validate(opt1.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
+            argValue, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt1.Name)
+            }
+            arg1 = argValue
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case setup.PanelSlaSetupCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if err := cmd.Properties().Arguments[1].Validate(opt1.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+        var arg2 *int
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            if err := cmd.Properties().Arguments[2].Validate(opt2.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
+            argValue, ok := opt2.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt2.Name)
+            }
+            tmp := int(argValue)
+            arg2 = &tmp
+        }
+        var arg3 *uint64
+
+        opt3, ok3 := findOption(cmd.Properties().Arguments[3], options)
+        if !ok3 {
+            arg3 = nil
+        } else {
+            raw, ok := opt3.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt3.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt3.Name)
+            }
+            arg3 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2, arg3)
+    case setup.PermissionSetupCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if err := cmd.Properties().Arguments[1].Validate(opt1.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+        var arg2 *uint64
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            raw, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt2.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2)
+    case setup.PrioritySetupCommand:
+        var arg0 bool
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(bool)
+            if !ok {
+                return fmt.Errorf("option %s was not a bool", opt0.Name)
+            }
+            arg0 = argValue
+
+            
+        }
+        var arg1 *uint64
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if err := cmd.Properties().Arguments[1].Validate(opt1.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            }
+            raw, ok := opt1.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt1.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt1.Name)
+            }
+            arg1 = &argValue
+        }
+        var arg2 *uint64
+
+        opt2, ok2 := findOption(cmd.Properties().Arguments[2], options)
+        if !ok2 {
+            arg2 = nil
+        } else {
+            raw, ok := opt2.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a snowflake", opt2.Name)
+            }
+
+            argValue, err := strconv.ParseUint(raw, 10, 64)
+            if err != nil {
+                return fmt.Errorf("option %s was not a valid snowflake", opt2.Name)
+            }
+            arg2 = &argValue
+        }
+
+        v.Execute(ctx, arg0, arg1, arg2)
     case setup.SetupCommand:
 
         v.Execute(ctx)
@@ -261,7 +510,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             return ErrArgumentNotFound
-        } else { 
+        } else {
+            if err := cmd.Properties().Arguments[0].Validate(opt0.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
             argValue, ok := opt0.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt0.Name)
@@ -302,6 +554,9 @@ func callCommand(
         v.Execute(ctx, arg0)
     case tags.ManageTagsListCommand:
 
+        v.Execute(ctx)
+    case tags.SaveAsTagCommand:
+
         v.Execute(ctx)
     case tags.TagCommand:
         var arg0 string
@@ -338,6 +593,9 @@ func callCommand(
         }
 
         v.Execute(ctx, arg0)
+    case tickets.AddToNotesCommand:
+
+        v.Execute(ctx)
     case tickets.ClaimCommand:
 
         v.Execute(ctx)
@@ -362,7 +620,10 @@ func callCommand(
         opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
         if !ok0 {
             arg0 = nil
-        } else { 
+        } else {
+            if err := cmd.Properties().Arguments[0].Validate(opt0.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
             argValue, ok := opt0.Value.(float64)
             if !ok {
                 return fmt.Errorf("option %s was not a float64", opt0.Name)
@@ -375,7 +636,10 @@ func callCommand(
         opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
         if !ok1 {
             arg1 = nil
-        } else { 
+        } else {
+            if err := cmd.Properties().Arguments[1].Validate(opt1.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
             argValue, ok := opt1.Value.(string)
             if !ok {
                 return fmt.Errorf("option %s was not a string", opt1.Name)
@@ -384,6 +648,55 @@ func callCommand(
         }
 
         v.Execute(ctx, arg0, arg1)
+    case tickets.HoldCommand:
+        var arg0 *string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            arg0 = nil
+        } else {
+            if err := cmd.Properties().Arguments[0].Validate(opt0.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = &argValue
+        }
+        var arg1 *int
+
+        opt1, ok1 := findOption(cmd.Properties().Arguments[1], options)
+        if !ok1 {
+            arg1 = nil
+        } else {
+            if err := cmd.Properties().Arguments[1].Validate(opt1.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
+            argValue, ok := opt1.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt1.Name)
+            }
+            tmp := int(argValue)
+            arg1 = &tmp
+        }
+
+        v.Execute(ctx, arg0, arg1)
+    case tickets.MergeCommand:
+        var arg0 int
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else { 
+            argValue, ok := opt0.Value.(float64)
+            if !ok {
+                return fmt.Errorf("option %s was not a float64", opt0.Name)
+            }
+            arg0 = int(argValue)
+        }
+
+        v.Execute(ctx, arg0)
     case tickets.NotesCommand:
 
         v.Execute(ctx)
@@ -404,6 +717,27 @@ func callCommand(
             arg0 = &argValue
         }
 
+        v.Execute(ctx, arg0)
+    case tickets.OpenTicketForUserCommand:
+
+        v.Execute(ctx)
+    case tickets.PriorityCommand:
+        var arg0 string
+
+        opt0, ok0 := findOption(cmd.Properties().Arguments[0], options)
+        if !ok0 {
+            return ErrArgumentNotFound
+        } else {
+            if err := cmd.Properties().Arguments[0].Validate(opt0.Value, ctx.Interaction.Data.Resolved); err != nil {
+                return err
+            } 
+            argValue, ok := opt0.Value.(string)
+            if !ok {
+                return fmt.Errorf("option %s was not a string", opt0.Name)
+            }
+            arg0 = argValue
+        }
+
         v.Execute(ctx, arg0)
     case tickets.RemoveCommand:
         var arg0 uint64
@@ -496,6 +830,12 @@ func callCommand(
     case tickets.UnclaimCommand:
 
         v.Execute(ctx)
+    case tickets.UnholdCommand:
+
+        v.Execute(ctx)
+    case tickets.ViewTicketsCommand:
+
+        v.Execute(ctx)
 
     
     case tags.TagAliasCommand:
@@ -0,0 +1,114 @@
+package event
+
+import (
+	"math/rand"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/eventforwarding"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	jsoniter "github.com/json-iterator/go"
+)
+
+type CaptureKind string
+
+const (
+	CaptureKindEvent       CaptureKind = "event"
+	CaptureKindInteraction CaptureKind = "interaction"
+)
+
+// CapturedPayload is a single line of a capture file. Payload holds an eventforwarding.Event or
+// eventforwarding.Interaction, depending on Kind, with the bot token removed.
+type CapturedPayload struct {
+	Kind       CaptureKind         `json:"kind"`
+	CapturedAt time.Time           `json:"captured_at"`
+	Payload    jsoniter.RawMessage `json:"payload"`
+}
+
+type payloadCapture struct {
+	mu         sync.Mutex
+	file       *os.File
+	sampleRate float64
+	guildIds   []uint64
+}
+
+// capture is nil unless capturing has been enabled with StartCapture
+var capture *payloadCapture
+
+// StartCapture writes received events and interactions to the JSONL file at path. Only payloads from the given guilds
+// are captured, unless guildIds is empty. Of those, sampleRate is the fraction that is captured.
+func StartCapture(path string, sampleRate float64, guildIds []uint64) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+
+	capture = &payloadCapture{
+		file:       file,
+		sampleRate: sampleRate,
+		guildIds:   guildIds,
+	}
+
+	return nil
+}
+
+func captureEvent(event eventforwarding.Event) {
+	if capture == nil || !capture.shouldCapture(eventGuildId(event.Event)) {
+		return
+	}
+
+	event.BotToken = ""
+	capture.write(CaptureKindEvent, event)
+}
+
+func captureInteraction(interaction eventforwarding.Interaction) {
+	if capture == nil || !capture.shouldCapture(interactionGuildId(interaction.Event)) {
+		return
+	}
+
+	interaction.BotToken = ""
+	capture.write(CaptureKindInteraction, interaction)
+}
+
+func (c *payloadCapture) shouldCapture(guildId uint64) bool {
+	if len(c.guildIds) > 0 && !utils.Contains(c.guildIds, guildId) {
+		return false
+	}
+
+	return rand.Float64() < c.sampleRate
+}
+
+func (c *payloadCapture) write(kind CaptureKind, payload any) {
+	marshalled, err := json.Marshal(payload)
+	if err != nil {
+		return
+	}
+
+	line, err := json.Marshal(CapturedPayload{
+		Kind:       kind,
+		CapturedAt: time.Now(),
+		Payload:    marshalled,
+	})
+	if err != nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, _ = c.file.Write(append(line, '\n'))
+}
+
+// interactionGuildId returns the ID of the guild an interaction was sent in, or 0 if it was sent in DMs.
+func interactionGuildId(data []byte) uint64 {
+	var interaction struct {
+		GuildId uint64 `json:"guild_id,string"`
+	}
+
+	if err := json.Unmarshal(data, &interaction); err != nil {
+		return 0
+	}
+
+	return interaction.GuildId
+}
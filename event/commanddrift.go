@@ -0,0 +1,99 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	worker "github.com/jadevelopmentgrp/Tickets-Worker"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
+	cmd_manager "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/manager"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
+	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/sirupsen/logrus"
+)
+
+const commandRegistrationTimeout = time.Second * 30
+
+var (
+	createPayloadOnce sync.Once
+	createPayload     []cmd_manager.CommandData
+	createPayloadHash string
+	createPayloadErr  error
+)
+
+// registrationDrifted returns true if the bot's commands were registered from a different payload to the one built by
+// this worker, in which case they are re-registered in the background. Only whitelabel bots are checked, as the public
+// bot's commands are registered by cmd/registercommands.
+func registrationDrifted(ctx context.Context, worker *worker.Context, commandManager *cmd_manager.CommandManager) bool {
+	if !worker.IsWhitelabel {
+		return false
+	}
+
+	createPayloadOnce.Do(func() {
+		createPayload = commandManager.BuildCreatePayload()
+		createPayloadHash, createPayloadErr = cmd_manager.PayloadHash(createPayload)
+	})
+
+	if createPayloadErr != nil {
+		logrus.Warnf("error hashing command payload: %v", createPayloadErr)
+		return false
+	}
+
+	// If the commands were registered through the dashboard, no hash will have been stored
+	stored, err := redis.GetCommandHash(ctx, worker.BotId)
+	if err != nil && !errors.Is(err, redis.ErrNil) {
+		logrus.Warnf("error retrieving command hash for bot %d: %v", worker.BotId, err)
+		return false
+	}
+
+	if stored == createPayloadHash {
+		return false
+	}
+
+	// Re-register at most once per interval, in case registration keeps failing
+	allowed, err := redis.TakeCommandRegistrationToken(ctx, worker.BotId)
+	if err != nil {
+		logrus.Warnf("error taking command registration token for bot %d: %v", worker.BotId, err)
+		return true
+	}
+
+	if allowed {
+		go reregisterCommands(worker)
+	}
+
+	return true
+}
+
+func reregisterCommands(worker *worker.Context) {
+	ctx, cancel := context.WithTimeout(worker.BaseContext(), commandRegistrationTimeout)
+	defer cancel()
+
+	if err := cmd_manager.RegisterGlobalCommands(ctx, worker, createPayload); err != nil {
+		logrus.Warnf("error re-registering commands for bot %d: %v", worker.BotId, err)
+		return
+	}
+
+	if err := redis.StoreCommandHash(ctx, worker.BotId, createPayloadHash); err != nil {
+		logrus.Warnf("error storing command hash for bot %d: %v", worker.BotId, err)
+		return
+	}
+
+	logrus.Infof("re-registered outdated commands for bot %d", worker.BotId)
+}
+
+func commandsUpdatingResponse(guildId uint64) interaction.ApplicationCommandCallbackData {
+	embed := utils.BuildEmbedRaw(
+		customisation.GetDefaultColour(customisation.Orange),
+		i18n.GetMessageFromGuild(guildId, i18n.TitleCommandsUpdating),
+		i18n.GetMessageFromGuild(guildId, i18n.MessageCommandsUpdating),
+		nil,
+	)
+
+	res := command.NewEphemeralEmbedMessageResponse(embed)
+	return res.IntoApplicationCommandData()
+}
@@ -5,52 +5,68 @@ import (
 	"errors"
 	"fmt"
 	"runtime/debug"
+	"strings"
 	"time"
 
 	"github.com/jadevelopmentgrp/Tickets-Utilities/permission"
 	worker "github.com/jadevelopmentgrp/Tickets-Worker"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/blacklist"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/button"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	cmdcontext "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/context"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command/impl/tags"
-	cmdregistry "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/registry"
+	cmd_manager "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/manager"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/commandpermission"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/cooldown"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/customisation"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/statsd"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/tracing"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/i18n"
 	"github.com/rxdn/gdl/objects/interaction"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
-// TODO: Command not found messages
-// (defaultDefer, error)
+// (defaultDefer, awaitModal, error)
+// If awaitModal is true, modalCh receives the modal the command responds with, or is closed once the command has run.
+// deferred is closed once the interaction has been responded to without a modal, after which the command can no longer
+// respond with one.
 func executeCommand(
 	ctx context.Context,
 	worker *worker.Context,
-	registry cmdregistry.Registry,
+	commandManager *cmd_manager.CommandManager,
 	data interaction.ApplicationCommandInteraction,
 	responseCh chan interaction.ApplicationCommandCallbackData,
-) (bool, error) {
+	modalCh chan button.ResponseModal,
+	deferred <-chan struct{},
+) (bool, bool, error) {
 	// data.Member is needed for permission level lookup
 	if data.GuildId.Value == 0 || data.Member == nil {
 		responseCh <- interaction.ApplicationCommandCallbackData{
 			Content: "Commands in DMs are not currently supported. Please run this command in a server.",
 		}
-		return false, nil
+		return false, false, nil
 	}
 
-	cmd, ok := registry[data.Data.Name]
+	cmd, ok := commandManager.GetCommands()[data.Data.Name]
 	if !ok {
 		// If a registered command is not found, check for a tag alias
 		tag, exists, err := dbclient.Client.Tag.GetByApplicationCommandId(ctx, data.GuildId.Value, data.Data.Id)
 		if err != nil {
-			return false, err
+			return false, false, err
 		}
 
 		if !exists {
-			return false, fmt.Errorf("command %s does not exist", data.Data.Name)
+			// The command may have been removed or renamed since the bot's commands were registered
+			if registrationDrifted(ctx, worker, commandManager) {
+				responseCh <- commandsUpdatingResponse(data.GuildId.Value)
+				return true, false, nil
+			}
+
+			return false, false, fmt.Errorf("command %s does not exist", data.Data.Name)
 		}
 
 		// Execute tag
@@ -58,6 +74,9 @@ func executeCommand(
 		ok = true
 	}
 
+	// Cooldowns are tracked per subcommand, so the full path of the command is needed
+	path := []string{data.Data.Name}
+
 	options := data.Data.Options
 	for len(options) > 0 && options[0].Value == nil { // Value and Options are mutually exclusive, value is never present on subcommands
 		subCommand := options[0]
@@ -72,15 +91,18 @@ func executeCommand(
 		}
 
 		if !found {
-			return false, fmt.Errorf("subcommand %s does not exist for command %s", subCommand.Name, cmd.Properties().Name)
+			return false, false, fmt.Errorf("subcommand %s does not exist for command %s", subCommand.Name, cmd.Properties().Name)
 		}
 
+		path = append(path, subCommand.Name)
 		options = subCommand.Options
 	}
 
 	properties := cmd.Properties()
 
 	go func() {
+		defer close(modalCh)
+
 		defer func() {
 			if r := recover(); r != nil {
 				fmt.Printf("Recovering panicking goroutine while executing command %s: %v\n", properties.Name, r)
@@ -97,8 +119,12 @@ func executeCommand(
 		// Get permission level
 		var permLevel = permission.Everyone
 		group.Go(func() error {
-			res, err := permission.GetPermissionLevel(lookupCtx, utils.ToRetriever(worker), *data.Member, data.GuildId.Value)
+			spanCtx, span := tracing.Tracer.Start(lookupCtx, "permission.GetPermissionLevel")
+			defer span.End()
+
+			res, err := permission.GetPermissionLevel(spanCtx, utils.ToRetriever(worker), *data.Member, data.GuildId.Value)
 			if err != nil {
+				tracing.RecordError(span, err)
 				return err
 			}
 
@@ -106,6 +132,13 @@ func executeCommand(
 			return nil
 		})
 
+		// Get the guild's overrides of who may run commands
+		var overrides commandpermission.Overrides
+		group.Go(func() (err error) {
+			overrides, err = commandpermission.Get(lookupCtx, data.GuildId.Value)
+			return
+		})
+
 		if err := group.Wait(); err != nil {
 			fmt.Print(err)
 			responseCh <- interaction.ApplicationCommandCallbackData{
@@ -117,7 +150,13 @@ func executeCommand(
 		ctx, cancel := context.WithTimeout(ctx, properties.Timeout)
 		defer cancel()
 
-		interactionContext := cmdcontext.NewSlashCommandContext(ctx, worker, data, responseCh)
+		// Only commands that are awaited may respond with a modal
+		commandModalCh := modalCh
+		if !properties.ModalResponse {
+			commandModalCh = nil
+		}
+
+		interactionContext := cmdcontext.NewSlashCommandContext(ctx, worker, data, responseCh, commandModalCh, deferred)
 
 		// Check if the guild is globally blacklisted
 		if blacklist.IsGuildBlacklisted(data.GuildId.Value) {
@@ -125,7 +164,8 @@ func executeCommand(
 			return
 		}
 
-		if properties.PermissionLevel > permLevel {
+		requirement := overrides.Requirement(path, properties.PermissionLevel)
+		if !requirement.IsMet(permLevel, *data.Member) {
 			interactionContext.Reply(customisation.Red, i18n.Error, i18n.MessageNoPermission)
 			return
 		}
@@ -154,15 +194,30 @@ func executeCommand(
 			return
 		}
 
+		if !cooldown.Take(&interactionContext, strings.Join(path, " "), properties.Cooldown, permLevel) {
+			return
+		}
+
 		statsd.Client.IncrementKey(statsd.KeySlashCommands)
 		statsd.Client.IncrementKey(statsd.KeyCommands)
 		prometheus.LogCommand(data.Data.Name)
 
 		defer close(responseCh)
 
+		var span trace.Span
+		interactionContext.Context, span = tracing.Tracer.Start(interactionContext.Context, fmt.Sprintf("command %s", properties.Name))
+		defer span.End()
+
 		if err := callCommand(cmd, &interactionContext, options); err != nil {
-			if errors.Is(err, ErrArgumentNotFound) {
-				if worker.IsWhitelabel {
+			tracing.RecordError(span, err)
+
+			var invalidArgument *command.InvalidArgumentError
+			if errors.As(err, &invalidArgument) {
+				interactionContext.Reply(customisation.Red, i18n.Error, invalidArgument.Argument.InvalidMessage)
+			} else if errors.Is(err, ErrArgumentNotFound) {
+				if registrationDrifted(ctx, worker, commandManager) {
+					responseCh <- commandsUpdatingResponse(data.GuildId.Value)
+				} else if worker.IsWhitelabel {
 					content := `This command registration is outdated. Please ask the server administrators to visit the whitelabel dashboard and press "Create Slash Commands" again.`
 					embed := utils.BuildEmbedRaw(customisation.GetDefaultColour(customisation.Red), "Outdated Command", content, nil)
 					res := command.NewEphemeralEmbedMessageResponse(embed)
@@ -180,5 +235,5 @@ func executeCommand(
 		}
 	}()
 
-	return properties.DefaultEphemeral, nil
+	return properties.DefaultEphemeral, properties.ModalResponse, nil
 }
@@ -0,0 +1,89 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/eventforwarding"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/listeners"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
+	"github.com/rxdn/gdl/cache"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// DeadLetter is published to the dead-letter topic when an event could not be handled, even after retrying
+type DeadLetter struct {
+	Event    eventforwarding.Event `json:"event"`
+	Error    string                `json:"error"`
+	Attempts int                   `json:"attempts"`
+	FailedAt time.Time             `json:"failed_at"`
+
+	// FailedListeners holds the index of each listener that failed, so that only those are run again when the event is
+	// replayed. If empty, all listeners are run.
+	FailedListeners []int `json:"failed_listeners,omitempty"`
+}
+
+type DeadLetterProducer struct {
+	client *kgo.Client
+}
+
+func NewDeadLetterProducer(brokers []string, topic string) (*DeadLetterProducer, error) {
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.DefaultProduceTopic(topic),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DeadLetterProducer{
+		client: client,
+	}, nil
+}
+
+func (p *DeadLetterProducer) Publish(ctx context.Context, event eventforwarding.Event, cause error, attempts int) error {
+	var failed []int
+	var listenerErr *listeners.ListenerError
+	if errors.As(cause, &listenerErr) {
+		failed = listenerErr.Failed
+	}
+
+	marshalled, err := json.Marshal(DeadLetter{
+		Event:           event,
+		Error:           cause.Error(),
+		Attempts:        attempts,
+		FailedAt:        time.Now(),
+		FailedListeners: failed,
+	})
+	if err != nil {
+		return err
+	}
+
+	record := &kgo.Record{
+		Value: marshalled,
+		Headers: []kgo.RecordHeader{
+			{Key: "error", Value: []byte(cause.Error())},
+		},
+	}
+
+	if err := p.client.ProduceSync(ctx, record).FirstErr(); err != nil {
+		return err
+	}
+
+	prometheus.DeadLetteredEvents.Inc()
+	return nil
+}
+
+func (p *DeadLetterProducer) Close() {
+	p.client.Close()
+}
+
+// ReplayDeadLetter executes the listeners that failed for a dead-lettered event again, without retrying.
+func ReplayDeadLetter(cache *cache.PgCache, deadLetter DeadLetter) error {
+	ctx, span := startEventSpan(context.Background(), deadLetter.Event)
+	defer span.End()
+
+	workerCtx := newEventContext(ctx, deadLetter.Event, cache, nil) // Use http-proxy ratelimit functionality
+	return execute(workerCtx, deadLetter.Event.Event, deadLetter.FailedListeners)
+}
@@ -1,26 +1,103 @@
 package event
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/jadevelopmentgrp/Tickets-Utilities/eventforwarding"
 	"github.com/jadevelopmentgrp/Tickets-Worker"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/listeners"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/tracing"
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"github.com/rxdn/gdl/cache"
 	"github.com/rxdn/gdl/gateway/payloads"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
-func execute(c *worker.Context, event []byte) error {
+// newEventContext builds the worker context for a gateway event. rateLimiter may be nil when requests are sent
+// through the http-proxy, which handles ratelimits itself. ctx should carry the span started for the event.
+func newEventContext(
+	ctx context.Context,
+	event eventforwarding.Event,
+	cache *cache.PgCache,
+	rateLimiter *ratelimit.Ratelimiter,
+) *worker.Context {
+	return &worker.Context{
+		Token:        event.BotToken,
+		BotId:        event.BotId,
+		IsWhitelabel: event.IsWhitelabel,
+		ShardId:      event.ShardId,
+		Cache:        cache,
+		RateLimiter:  rateLimiter,
+		TraceContext: ctx,
+	}
+}
+
+// startEventSpan starts the root span for handling a gateway event. The span is renamed once the event has been
+// decoded.
+func startEventSpan(ctx context.Context, event eventforwarding.Event) (context.Context, trace.Span) {
+	return tracing.Tracer.Start(ctx, "event",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithAttributes(
+			attribute.Int64("bot.id", int64(event.BotId)),
+			attribute.Bool("bot.whitelabel", event.IsWhitelabel),
+			attribute.Int("shard.id", event.ShardId),
+		),
+	)
+}
+
+// execute handles the event. If only is not nil, just the listeners at those indexes are run.
+func execute(c *worker.Context, event []byte, only []int) error {
 	var payload payloads.Payload
 	if err := json.Unmarshal(event, &payload); err != nil {
-		return errors.New(fmt.Sprintf("error whilst decoding event data: %s (data: %s)", err.Error(), string(event)))
+		return fmt.Errorf("%w: error whilst decoding event data: %w (data: %s)", listeners.ErrInvalidEvent, err, string(event))
 	}
 
 	prometheus.Events.WithLabelValues(payload.EventName).Inc()
 
-	if err := listeners.HandleEvent(c, payload); err != nil {
+	span := trace.SpanFromContext(c.BaseContext())
+	span.SetName(fmt.Sprintf("event %s", payload.EventName))
+
+	if err := listeners.HandleEvent(c, payload, only); err != nil {
+		tracing.RecordError(span, err)
 		return err
 	}
 
 	return nil
 }
+
+// executeWithRetry executes the event, retrying with exponential backoff if any listeners fail. Only the listeners that
+// failed are run again, so that listeners with side effects, such as sending messages, are not repeated. Events that
+// cannot be decoded are not retried, as they will never succeed. Returns the number of attempts made.
+func executeWithRetry(ctx context.Context, c *worker.Context, event []byte) (int, error) {
+	backoff := config.Conf.Kafka.RetryBackoff
+
+	var only []int
+	for attempt := 1; ; attempt++ {
+		err := execute(c, event, only)
+		if err == nil {
+			return attempt, nil
+		}
+
+		var listenerErr *listeners.ListenerError
+		if !errors.As(err, &listenerErr) || attempt > config.Conf.Kafka.RetryAttempts {
+			return attempt, err
+		}
+
+		only = listenerErr.Failed
+		prometheus.EventRetries.Inc()
+
+		select {
+		case <-ctx.Done():
+			return attempt, err
+		case <-time.After(backoff):
+		}
+
+		backoff *= 2
+	}
+}
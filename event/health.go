@@ -0,0 +1,130 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/dbclient"
+	"github.com/rxdn/gdl/cache"
+)
+
+const readinessCheckTimeout = time.Second * 2
+
+var ready atomic.Bool
+
+// MarkReady should be called once startup has completed, after which /readyz reports the status of dependencies.
+func MarkReady() {
+	ready.Store(true)
+}
+
+type dependency struct {
+	name     string
+	required bool
+	ping     func(ctx context.Context) error
+}
+
+type dependencyStatus struct {
+	Healthy  bool   `json:"healthy"`
+	Required bool   `json:"required"`
+	Error    string `json:"error,omitempty"`
+}
+
+type readinessResponse struct {
+	Ready        bool                        `json:"ready"`
+	Dependencies map[string]dependencyStatus `json:"dependencies"`
+}
+
+func healthHandler(ctx *gin.Context) {
+	ctx.JSON(200, successResponse)
+}
+
+func readinessHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Context) {
+	dependencies := []dependency{
+		{
+			name:     "redis",
+			required: true,
+			ping: func(ctx context.Context) error {
+				return redis.Ping(ctx).Err()
+			},
+		},
+		{
+			name:     "database",
+			required: true,
+			ping:     dbclient.Ping,
+		},
+		{
+			name:     "cache",
+			required: true,
+			ping:     cache.Ping,
+		},
+		{
+			name:     "clickhouse",
+			required: false,
+			ping: func(ctx context.Context) error {
+				if dbclient.Analytics == nil {
+					return errors.New("not connected")
+				}
+
+				return dbclient.Analytics.Ping(ctx)
+			},
+		},
+	}
+
+	return func(ctx *gin.Context) {
+		if !ready.Load() {
+			ctx.JSON(503, readinessResponse{
+				Ready:        false,
+				Dependencies: map[string]dependencyStatus{},
+			})
+			return
+		}
+
+		pingCtx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
+		defer cancel()
+
+		res := readinessResponse{
+			Ready:        true,
+			Dependencies: make(map[string]dependencyStatus, len(dependencies)),
+		}
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		for _, dep := range dependencies {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				status := dependencyStatus{
+					Healthy:  true,
+					Required: dep.required,
+				}
+
+				if err := dep.ping(pingCtx); err != nil {
+					status.Healthy = false
+					status.Error = err.Error()
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				res.Dependencies[dep.name] = status
+				if !status.Healthy && status.Required {
+					res.Ready = false
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if res.Ready {
+			ctx.JSON(200, res)
+		} else {
+			ctx.JSON(503, res)
+		}
+	}
+}
@@ -2,8 +2,11 @@ package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
@@ -15,6 +18,7 @@ import (
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/command"
 	cmd_manager "github.com/jadevelopmentgrp/Tickets-Worker/bot/command/manager"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/tracing"
 	"github.com/jadevelopmentgrp/Tickets-Worker/bot/utils"
 	"github.com/jadevelopmentgrp/Tickets-Worker/config"
 	"github.com/rxdn/gdl/cache"
@@ -22,6 +26,8 @@ import (
 	"github.com/rxdn/gdl/objects/interaction"
 	"github.com/rxdn/gdl/rest"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type response struct {
@@ -46,7 +52,12 @@ var successResponse = response{
 	Success: true,
 }
 
-func HttpListen(redis *redis.Client, cache *cache.PgCache) {
+// inFlight tracks interactions that have been acknowledged, but are still being processed in the background
+var inFlight sync.WaitGroup
+
+// HttpListen serves HTTP requests until ctx is cancelled. It then stops accepting new requests, and waits for in-flight
+// interactions to complete, up to config.Conf.ShutdownTimeout.
+func HttpListen(ctx context.Context, redis *redis.Client, cache *cache.PgCache) {
 	router := gin.New()
 
 	// Middleware
@@ -58,12 +69,43 @@ func HttpListen(redis *redis.Client, cache *cache.PgCache) {
 	}
 
 	// Routes
+	router.GET("/healthz", healthHandler)
+	router.GET("/readyz", readinessHandler(redis, cache))
 	router.POST("/event", eventHandler(cache))
 	router.POST("/interaction", interactionHandler(redis, cache))
 
-	if err := router.Run(config.Conf.Bot.HttpAddress); err != nil {
+	server := &http.Server{
+		Addr:    config.Conf.Bot.HttpAddress,
+		Handler: router,
+	}
+
+	shutdownCh := make(chan struct{})
+	go func() {
+		defer close(shutdownCh)
+
+		<-ctx.Done()
+		deadline := time.Now().Add(config.Conf.ShutdownTimeout)
+
+		shutdownCtx, cancel := context.WithDeadline(context.Background(), deadline)
+		defer cancel()
+
+		// Wait for open requests to be responded to, after which no more interactions can be started
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			logrus.Warnf("error shutting down http server: %v", err)
+		}
+
+		if utils.WaitTimeout(&inFlight, time.Until(deadline)) {
+			logrus.Info("all in-flight interactions completed")
+		} else {
+			logrus.Warn("timed out waiting for in-flight interactions to complete")
+		}
+	}()
+
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 		panic(err)
 	}
+
+	<-shutdownCh
 }
 
 func metricsMiddleware(c *gin.Context) {
@@ -80,18 +122,16 @@ func eventHandler(cache *cache.PgCache) func(*gin.Context) {
 			return
 		}
 
-		workerCtx := &worker.Context{
-			Token:        event.BotToken,
-			BotId:        event.BotId,
-			IsWhitelabel: event.IsWhitelabel,
-			ShardId:      event.ShardId,
-			Cache:        cache,
-			RateLimiter:  nil, // Use http-proxy ratelimit functionality
-		}
+		captureEvent(event)
+
+		spanCtx, span := startEventSpan(tracing.ExtractFromHeaders(c.Request.Header), event)
+		defer span.End()
+
+		workerCtx := newEventContext(spanCtx, event, cache, nil) // Use http-proxy ratelimit functionality
 
 		c.AbortWithStatusJSON(200, successResponse)
 
-		if err := execute(workerCtx, event.Event); err != nil {
+		if err := execute(workerCtx, event.Event, nil); err != nil {
 			marshalled, _ := json.Marshal(event)
 			logrus.Warnf("error executing event: %v (payload: %s)", err, string(marshalled))
 		}
@@ -104,24 +144,57 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 	commandManager.RunSetupFuncs()
 
 	buttonManager := btn_manager.NewButtonManager()
-	buttonManager.RegisterCommands()
+	buttonManager.RegisterCommands(commandManager.GetCommands())
+
+	publicKey, err := parsePublicKey(config.Conf.Discord.PublicKey)
+	if err != nil {
+		panic(err)
+	}
 
 	return func(ctx *gin.Context) {
-		var payload eventforwarding.Interaction
-		if err := ctx.BindJSON(&payload); err != nil {
-			ctx.JSON(400, newErrorResponse(err))
+		payload, err := readInteractionPayload(ctx, publicKey, config.Conf.Discord.ForwardedInteractionSecret)
+		if err != nil {
+			if errors.Is(err, ErrInvalidSignature) || errors.Is(err, ErrMissingPublicKey) || errors.Is(err, ErrInvalidForwardedSecret) {
+				ctx.JSON(401, newErrorResponse(err))
+			} else {
+				ctx.JSON(400, newErrorResponse(err))
+			}
+
 			return
 		}
 
+		captureInteraction(payload)
+
+		// The span is ended once the response has been sent, unless it is handed off to the goroutine that sends
+		// followup responses
+		spanCtx, span := tracing.Tracer.Start(tracing.ExtractFromHeaders(ctx.Request.Header), "interaction",
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.Int("interaction.type", int(payload.InteractionType)),
+				attribute.Int64("bot.id", int64(payload.BotId)),
+				attribute.Bool("bot.whitelabel", payload.IsWhitelabel),
+			),
+		)
+
+		handedOff := false
+		defer func() {
+			if !handedOff {
+				span.End()
+			}
+		}()
+
 		worker := &worker.Context{
 			Token:        payload.BotToken,
 			BotId:        payload.BotId,
 			IsWhitelabel: payload.IsWhitelabel,
 			Cache:        cache,
 			RateLimiter:  nil, // Use http-proxy ratelimit functionality
+			TraceContext: spanCtx,
 		}
 
 		switch payload.InteractionType {
+		case interaction.InteractionTypePing:
+			ctx.JSON(200, interaction.NewResponsePong())
 		case interaction.InteractionTypeApplicationCommand:
 			var interactionData interaction.ApplicationCommandInteraction
 			if err := json.Unmarshal(payload.Event, &interactionData); err != nil {
@@ -129,10 +202,23 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 				return
 			}
 
+			if !claimInteraction(ctx, payload.InteractionType, interactionData.Id) {
+				return
+			}
+
+			span.SetName(fmt.Sprintf("interaction /%s", interactionData.Data.Name))
+			span.SetAttributes(attribute.Int64("guild.id", int64(interactionData.GuildId.Value)))
+
 			responseCh := make(chan interaction.ApplicationCommandCallbackData, 1)
+			// Unbuffered, so that a modal is only sent while it can still be the initial response
+			modalCh := make(chan button.ResponseModal)
+			deferred := make(chan struct{})
 
-			deferDefault, err := executeCommand(ctx, worker, commandManager.GetCommands(), interactionData, responseCh)
+			deferDefault, awaitModal, err := executeCommand(spanCtx, worker, commandManager, interactionData, responseCh, modalCh, deferred)
 			if err != nil {
+				tracing.RecordError(span, err)
+				releaseInteraction(ctx, interactionData.Id)
+
 				marshalled, _ := json.Marshal(payload)
 				logrus.Warnf("error executing payload: %v (payload: %s)", err, string(marshalled))
 				return
@@ -143,11 +229,29 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 				flags = message.SumFlags(message.FlagEphemeral)
 			}
 
-			res := interaction.NewResponseAckWithSource(flags)
-			ctx.JSON(200, res)
-			ctx.Writer.Flush()
+			// A modal must be the initial response, so wait for the command to run before deferring
+			var modal *button.ResponseModal
+			if awaitModal {
+				select {
+				case <-time.After(calculateTimeToDefer(interactionData.Id)):
+				case res, ok := <-modalCh:
+					if ok {
+						modal = &res
+					}
+				}
+			}
+
+			close(deferred)
 
-			go handleApplicationCommandResponseAfterDefer(interactionData, worker, responseCh)
+			if modal != nil {
+				respond(ctx, interactionData.Id, modal.Build())
+			} else {
+				respond(ctx, interactionData.Id, interaction.NewResponseAckWithSource(flags))
+			}
+
+			inFlight.Add(1)
+			handedOff = true
+			go handleApplicationCommandResponseAfterDefer(interactionData, worker, responseCh, span)
 
 			prometheus.InteractionTimeToReceive.Observe(calculateTimeToReceive(interactionData.Id).Seconds())
 		case interaction.InteractionTypeMessageComponent:
@@ -157,22 +261,31 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 				return
 			}
 
+			if !claimInteraction(ctx, payload.InteractionType, interactionData.Id) {
+				return
+			}
+
+			span.SetName("interaction component")
+			span.SetAttributes(
+				attribute.Int("interaction.component_type", int(interactionData.Data.Type())),
+				attribute.Int64("guild.id", int64(interactionData.GuildId.Value)),
+			)
+
 			timeToDefer := calculateTimeToDefer(interactionData.Id)
 
 			responseCh := make(chan button.Response, 1) // Buffer > 0 is important, or it could hang!
-			btn_manager.HandleInteraction(ctx, buttonManager, worker, interactionData, responseCh)
+			btn_manager.HandleInteraction(spanCtx, buttonManager, worker, interactionData, responseCh)
 
 			select {
 			case <-time.After(timeToDefer):
-				res := interaction.NewResponseDeferredMessageUpdate()
-				ctx.JSON(200, res)
-				ctx.Writer.Flush()
+				respond(ctx, interactionData.Id, interaction.NewResponseDeferredMessageUpdate())
 			case data := <-responseCh:
-				ctx.JSON(200, data.Build())
-				ctx.Writer.Flush()
+				respond(ctx, interactionData.Id, data.Build())
 			}
 
-			go handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, time.Now(), responseCh)
+			inFlight.Add(1)
+			handedOff = true
+			go handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, time.Now(), responseCh, span)
 
 			prometheus.InteractionTimeToReceive.Observe(calculateTimeToReceive(interactionData.Id).Seconds())
 			prometheus.InteractionTimeToDefer.Observe(timeToDefer.Seconds())
@@ -183,6 +296,8 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 				return
 			}
 
+			span.SetName(fmt.Sprintf("autocomplete /%s", interactionData.Data.Name))
+
 			cmd, ok := commandManager.GetCommands()[interactionData.Data.Name]
 			if !ok {
 				logrus.Warnf("autocomplete for invalid command: %s", interactionData.Data.Name)
@@ -210,25 +325,18 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 				options = subCommand.Options
 			}
 
-			focused := findFocusedOption(interactionData.Data.Options)
-			if focused == nil {
+			argument, focused, filled, ok := splitFocusedOption(cmd.Properties().Arguments, options)
+			if !ok {
 				logrus.Warnf("focused option not found")
 				return
 			}
 
-			var handler command.AutoCompleteHandler
-			for _, arg := range cmd.Properties().Arguments {
-				if strings.ToLower(arg.Name) == strings.ToLower(focused.Name) {
-					handler = arg.AutoCompleteHandler
-				}
-			}
-
-			if handler == nil {
-				logrus.Warnf("autocomplete for argument without handler: %s", focused.Name)
+			if argument.AutoCompleteHandler == nil {
+				logrus.Warnf("autocomplete for argument without handler: %s", argument.Name)
 				return
 			}
 
-			choices := handler(interactionData, fmt.Sprintf("%v", focused.Value))
+			choices := argument.FilterChoices(argument.AutoCompleteHandler(interactionData, focused, filled))
 			res := interaction.NewApplicationCommandAutoCompleteResultResponse(choices)
 			ctx.JSON(200, res)
 			ctx.Writer.Flush()
@@ -240,24 +348,42 @@ func interactionHandler(redis *redis.Client, cache *cache.PgCache) func(*gin.Con
 				return
 			}
 
-			ctx.JSON(200, interaction.NewResponseDeferredMessageUpdate())
-			ctx.Writer.Flush()
+			if !claimInteraction(ctx, payload.InteractionType, interactionData.Id) {
+				return
+			}
+
+			span.SetName("interaction modal")
+			span.SetAttributes(
+				attribute.String("interaction.custom_id", interactionData.Data.CustomId),
+				attribute.Int64("guild.id", int64(interactionData.GuildId.Value)),
+			)
+
+			respond(ctx, interactionData.Id, interaction.NewResponseDeferredMessageUpdate())
 
 			responseCh := make(chan button.Response, 1)
-			btn_manager.HandleModalInteraction(ctx, buttonManager, worker, interactionData, responseCh)
+			btn_manager.HandleModalInteraction(spanCtx, buttonManager, worker, interactionData, responseCh)
 
-			go handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, time.Now(), responseCh)
+			inFlight.Add(1)
+			handedOff = true
+			go handleButtonResponseAfterDefer(interactionData.InteractionMetadata, worker, time.Now(), responseCh, span)
 		}
 	}
 }
 
-func handleApplicationCommandResponseAfterDefer(interactionData interaction.ApplicationCommandInteraction, worker *worker.Context, responseCh chan interaction.ApplicationCommandCallbackData) {
+func handleApplicationCommandResponseAfterDefer(
+	interactionData interaction.ApplicationCommandInteraction,
+	worker *worker.Context,
+	responseCh chan interaction.ApplicationCommandCallbackData,
+	span trace.Span,
+) {
 	deferredAt := time.Now()
 	hasReplied := false
 
 	start := time.Now()
 	prometheus.ActiveInteractions.Inc()
 	defer func() {
+		span.End()
+		inFlight.Done()
 		prometheus.ActiveInteractions.Dec()
 		prometheus.InteractionTimeToComplete.Observe(time.Since(start).Seconds())
 	}()
@@ -271,8 +397,7 @@ func handleApplicationCommandResponseAfterDefer(interactionData interaction.Appl
 				return
 			}
 
-			if time.Now().Sub(utils.SnowflakeToTime(interactionData.Id)) > time.Minute*14 ||
-				deferredAt.Sub(utils.SnowflakeToTime(interactionData.Id)) > config.Conf.Discord.DeferHardTimeout {
+			if interactionExpired(interactionData.Id, deferredAt) {
 				return
 			}
 
@@ -284,7 +409,7 @@ func handleApplicationCommandResponseAfterDefer(interactionData interaction.Appl
 					Components:      data.Components,
 				}
 
-				if _, err := rest.CreateFollowupMessage(context.Background(), interactionData.Token, worker.RateLimiter, worker.BotId, restData); err != nil {
+				if _, err := rest.CreateFollowupMessage(worker.BaseContext(), interactionData.Token, worker.RateLimiter, worker.BotId, restData); err != nil {
 					fmt.Print(err, NewApplicationCommandInteractionErrorContext(interactionData))
 					return
 				}
@@ -298,7 +423,7 @@ func handleApplicationCommandResponseAfterDefer(interactionData interaction.Appl
 					Components:      data.Components,
 				}
 
-				if _, err := rest.EditOriginalInteractionResponse(context.Background(), interactionData.Token, worker.RateLimiter, worker.BotId, restData); err != nil {
+				if _, err := rest.EditOriginalInteractionResponse(worker.BaseContext(), interactionData.Token, worker.RateLimiter, worker.BotId, restData); err != nil {
 					fmt.Print(err, NewApplicationCommandInteractionErrorContext(interactionData))
 					return
 				}
@@ -307,10 +432,18 @@ func handleApplicationCommandResponseAfterDefer(interactionData interaction.Appl
 	}
 }
 
-func handleButtonResponseAfterDefer(interactionData interaction.InteractionMetadata, worker *worker.Context, deferredAt time.Time, ch chan button.Response) {
+func handleButtonResponseAfterDefer(
+	interactionData interaction.InteractionMetadata,
+	worker *worker.Context,
+	deferredAt time.Time,
+	ch chan button.Response,
+	span trace.Span,
+) {
 	start := time.Now()
 	prometheus.ActiveInteractions.Inc()
 	defer func() {
+		span.End()
+		inFlight.Done()
 		prometheus.ActiveInteractions.Dec()
 		prometheus.InteractionTimeToComplete.Observe(time.Since(start).Seconds())
 	}()
@@ -324,8 +457,7 @@ func handleButtonResponseAfterDefer(interactionData interaction.InteractionMetad
 				return
 			}
 
-			if time.Now().Sub(utils.SnowflakeToTime(interactionData.Id)) > time.Minute*14 ||
-				deferredAt.Sub(utils.SnowflakeToTime(interactionData.Id)) > config.Conf.Discord.DeferHardTimeout {
+			if interactionExpired(interactionData.Id, deferredAt) {
 				return
 			}
 
@@ -336,21 +468,52 @@ func handleButtonResponseAfterDefer(interactionData interaction.InteractionMetad
 	}
 }
 
-// TODO: Handle other data types
-func findFocusedOption(options []interaction.ApplicationCommandInteractionDataOption) *interaction.ApplicationCommandInteractionDataOption {
+// interactionExpired returns true if it is too late to respond to the interaction. Captured interactions are always
+// responded to when being replayed, regardless of their age.
+func interactionExpired(interactionId uint64, deferredAt time.Time) bool {
+	if replaying {
+		return false
+	}
+
+	return time.Now().Sub(utils.SnowflakeToTime(interactionId)) > time.Minute*14 ||
+		deferredAt.Sub(utils.SnowflakeToTime(interactionId)) > config.Conf.Discord.DeferHardTimeout
+}
+
+// splitFocusedOption returns the argument that the focused option was given for and its value, and the values of the
+// other options that have been filled in. options must be the options of the subcommand being run, as the focused
+// option is always one of them.
+func splitFocusedOption(
+	arguments []command.Argument,
+	options []interaction.ApplicationCommandInteractionDataOption,
+) (command.Argument, command.AutoCompleteValue, command.AutoCompleteOptions, bool) {
+	var focusedArgument command.Argument
+	var focused command.AutoCompleteValue
+	var found bool
+
+	filled := make(command.AutoCompleteOptions)
 	for _, option := range options {
-		if option.Focused {
-			return &option
+		var argument command.Argument
+		var ok bool
+		for _, arg := range arguments {
+			if strings.EqualFold(arg.Name, option.Name) {
+				argument, ok = arg, true
+				break
+			}
 		}
 
-		if option.Options != nil {
-			if focused := findFocusedOption(option.Options); focused != nil {
-				return focused
-			}
+		if !ok {
+			continue
+		}
+
+		value := command.NewAutoCompleteValue(argument, option.Value)
+		if option.Focused {
+			focusedArgument, focused, found = argument, value, true
+		} else {
+			filled[argument.Name] = value
 		}
 	}
 
-	return nil
+	return focusedArgument, focused, filled, found
 }
 
 func calculateTimeToReceive(interactionId uint64) time.Duration {
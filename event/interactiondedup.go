@@ -0,0 +1,100 @@
+package event
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/sirupsen/logrus"
+)
+
+const duplicatePollInterval = time.Millisecond * 100
+
+// claimInteraction ensures that an interaction is only handled once if the proxy delivers it more than once, for
+// example when retrying after a timeout. Returns false if the interaction has already been delivered, in which case
+// the response to the original delivery has been sent again and the interaction must not be handled.
+func claimInteraction(ctx *gin.Context, interactionType interaction.InteractionType, interactionId uint64) bool {
+	// Captured interactions are always handled when being replayed
+	if replaying {
+		return true
+	}
+
+	claimed, err := redis.ClaimInteraction(ctx, interactionId)
+	if err != nil {
+		// Handling the interaction twice is preferable to not handling it at all
+		logrus.Warnf("error claiming interaction %d: %v", interactionId, err)
+		return true
+	}
+
+	if claimed {
+		return true
+	}
+
+	prometheus.DuplicateInteractions.WithLabelValues(strconv.Itoa(int(interactionType))).Inc()
+
+	// The original delivery may still be waiting on a handler before responding
+	deadline := time.Now().Add(calculateTimeToDefer(interactionId))
+	for {
+		res, err := redis.GetInteractionResponse(ctx, interactionId)
+		if err == nil {
+			ctx.Data(http.StatusOK, gin.MIMEJSON, res)
+			return false
+		}
+
+		if !errors.Is(err, redis.ErrInteractionPending) {
+			logrus.Warnf("error retrieving response for duplicate interaction %d: %v", interactionId, err)
+			break
+		}
+
+		if time.Now().After(deadline) {
+			break
+		}
+
+		time.Sleep(duplicatePollInterval)
+	}
+
+	// Any responses from the original delivery are sent as followups, so deferring is always safe
+	if interactionType == interaction.InteractionTypeApplicationCommand {
+		ctx.JSON(http.StatusOK, interaction.NewResponseAckWithSource(0))
+	} else {
+		ctx.JSON(http.StatusOK, interaction.NewResponseDeferredMessageUpdate())
+	}
+
+	return false
+}
+
+// releaseInteraction is called when handling an interaction fails before it has been responded to, so that the user
+// is able to retry it.
+func releaseInteraction(ctx *gin.Context, interactionId uint64) {
+	if replaying {
+		return
+	}
+
+	if err := redis.ReleaseInteraction(ctx, interactionId); err != nil {
+		logrus.Warnf("error releasing interaction %d: %v", interactionId, err)
+	}
+}
+
+// respond sends the initial response to the interaction, storing it so that it can be sent again if the interaction is
+// redelivered.
+func respond(ctx *gin.Context, interactionId uint64, res any) {
+	marshalled, err := json.Marshal(res)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, newErrorResponse(err))
+		return
+	}
+
+	if !replaying {
+		if err := redis.StoreInteractionResponse(ctx, interactionId, marshalled); err != nil {
+			logrus.Warnf("error storing response for interaction %d: %v", interactionId, err)
+		}
+	}
+
+	ctx.Data(http.StatusOK, gin.MIMEJSON, marshalled)
+	ctx.Writer.Flush()
+}
@@ -0,0 +1,62 @@
+package event
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	goredis "github.com/go-redis/redis/v8"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/redis"
+	"github.com/rxdn/gdl/objects/interaction"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuplicateInteractionReturnsOriginalResponse(t *testing.T) {
+	useMiniredis(t)
+
+	const interactionId = 1022471243373928539
+
+	original := httptest.NewRecorder()
+	ctx, _ := gin.CreateTestContext(original)
+	require.True(t, claimInteraction(ctx, interaction.InteractionTypeApplicationCommand, interactionId))
+	respond(ctx, interactionId, interaction.NewResponseAckWithSource(64))
+
+	duplicate := httptest.NewRecorder()
+	ctx, _ = gin.CreateTestContext(duplicate)
+	require.False(t, claimInteraction(ctx, interaction.InteractionTypeApplicationCommand, interactionId))
+	require.Equal(t, original.Body.String(), duplicate.Body.String())
+}
+
+func TestDistinctInteractionsAreClaimed(t *testing.T) {
+	useMiniredis(t)
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	require.True(t, claimInteraction(ctx, interaction.InteractionTypeMessageComponent, 1))
+	require.True(t, claimInteraction(ctx, interaction.InteractionTypeMessageComponent, 2))
+}
+
+func TestReleasedInteractionIsClaimedAgain(t *testing.T) {
+	useMiniredis(t)
+
+	const interactionId = 1022471243373928539
+
+	ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+	require.True(t, claimInteraction(ctx, interaction.InteractionTypeApplicationCommand, interactionId))
+
+	// The command failed before responding, so a retry must be handled rather than waiting for the response
+	releaseInteraction(ctx, interactionId)
+	require.True(t, claimInteraction(ctx, interaction.InteractionTypeApplicationCommand, interactionId))
+}
+
+func useMiniredis(t *testing.T) {
+	mr := miniredis.RunT(t)
+
+	previous := redis.Client
+	redis.Client = goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+
+	t.Cleanup(func() {
+		_ = redis.Client.Close()
+		redis.Client = previous
+	})
+}
@@ -0,0 +1,113 @@
+package event
+
+import (
+	"crypto/ed25519"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/eventforwarding"
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"github.com/rxdn/gdl/objects/interaction"
+)
+
+var (
+	ErrMissingPublicKey = errors.New("received a raw interaction, but no public key is configured")
+	ErrInvalidSignature = errors.New("invalid request signature")
+
+	ErrInvalidForwardedSecret = errors.New("received a forwarded interaction without a valid secret")
+)
+
+// forwardedSecretHeader carries the secret shared with the proxy, if one is configured
+const forwardedSecretHeader = "X-Forwarded-Interaction-Secret"
+
+// rawInteractionHeader is used to determine whether an /interaction body was forwarded by the proxy, which wraps the
+// interaction in an eventforwarding.Interaction, or was sent by Discord directly.
+type rawInteractionHeader struct {
+	Type            interaction.InteractionType  `json:"type"`
+	InteractionType *interaction.InteractionType `json:"interaction_type"`
+}
+
+func parsePublicKey(encoded string) (ed25519.PublicKey, error) {
+	if encoded == "" {
+		return nil, nil
+	}
+
+	key, err := hex.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(key) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("public key must be %d bytes, got %d", ed25519.PublicKeySize, len(key))
+	}
+
+	return key, nil
+}
+
+// readInteractionPayload reads the body of an /interaction request. Payloads forwarded by the proxy are not signed by
+// Discord, so they are authenticated by the forwarded secret instead, if one is configured. Interactions sent directly
+// by Discord must carry a valid signature, and are wrapped using the public bot's credentials.
+func readInteractionPayload(ctx *gin.Context, publicKey ed25519.PublicKey, forwardedSecret string) (eventforwarding.Interaction, error) {
+	body, err := ctx.GetRawData()
+	if err != nil {
+		return eventforwarding.Interaction{}, err
+	}
+
+	var header rawInteractionHeader
+	if err := json.Unmarshal(body, &header); err != nil {
+		return eventforwarding.Interaction{}, err
+	}
+
+	if header.InteractionType != nil {
+		if forwardedSecret != "" && !verifyForwardedSecret(forwardedSecret, ctx.GetHeader(forwardedSecretHeader)) {
+			return eventforwarding.Interaction{}, ErrInvalidForwardedSecret
+		}
+
+		var payload eventforwarding.Interaction
+		if err := json.Unmarshal(body, &payload); err != nil {
+			return eventforwarding.Interaction{}, err
+		}
+
+		return payload, nil
+	}
+
+	if publicKey == nil {
+		return eventforwarding.Interaction{}, ErrMissingPublicKey
+	}
+
+	if !verifySignature(publicKey, ctx.GetHeader("X-Signature-Ed25519"), ctx.GetHeader("X-Signature-Timestamp"), body) {
+		return eventforwarding.Interaction{}, ErrInvalidSignature
+	}
+
+	return eventforwarding.Interaction{
+		BotToken:        config.Conf.Discord.Token,
+		BotId:           config.Conf.Discord.PublicBotId,
+		IsWhitelabel:    false,
+		InteractionType: header.Type,
+		Event:           body,
+	}, nil
+}
+
+func verifyForwardedSecret(secret, received string) bool {
+	return subtle.ConstantTimeCompare([]byte(secret), []byte(received)) == 1
+}
+
+func verifySignature(publicKey ed25519.PublicKey, signature, timestamp string, body []byte) bool {
+	if signature == "" || timestamp == "" {
+		return false
+	}
+
+	decoded, err := hex.DecodeString(signature)
+	if err != nil || len(decoded) != ed25519.SignatureSize {
+		return false
+	}
+
+	msg := make([]byte, 0, len(timestamp)+len(body))
+	msg = append(msg, timestamp...)
+	msg = append(msg, body...)
+
+	return ed25519.Verify(publicKey, msg, decoded)
+}
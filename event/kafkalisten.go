@@ -2,24 +2,34 @@ package event
 
 import (
 	"context"
+	"errors"
+	"time"
+
 	"github.com/jadevelopmentgrp/Tickets-Utilities/eventforwarding"
 	"github.com/jadevelopmentgrp/Tickets-Utilities/rpc"
-	"github.com/jadevelopmentgrp/Tickets-Worker"
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/listeners"
 	"github.com/rxdn/gdl/cache"
 	"go.uber.org/zap"
 )
 
+// deadLetterTimeout is how long to wait for an event to be published to the dead-letter topic
+const deadLetterTimeout = time.Second * 10
+
 type KafkaConsumer struct {
-	logger *zap.Logger
-	cache  *cache.PgCache
+	logger     *zap.Logger
+	cache      *cache.PgCache
+	deadLetter *DeadLetterProducer
 }
 
 var _ rpc.Listener = (*KafkaConsumer)(nil)
 
-func NewKafkaListener(logger *zap.Logger, cache *cache.PgCache) *KafkaConsumer {
+// NewKafkaListener creates a listener for gateway events. deadLetter may be nil, in which case events that fail after
+// retrying are dropped.
+func NewKafkaListener(logger *zap.Logger, cache *cache.PgCache, deadLetter *DeadLetterProducer) *KafkaConsumer {
 	return &KafkaConsumer{
-		logger: logger,
-		cache:  cache,
+		logger:     logger,
+		cache:      cache,
+		deadLetter: deadLetter,
 	}
 }
 
@@ -34,16 +44,35 @@ func (k *KafkaConsumer) HandleMessage(ctx context.Context, message []byte) {
 		return
 	}
 
-	workerCtx := &worker.Context{
-		Token:        event.BotToken,
-		BotId:        event.BotId,
-		IsWhitelabel: event.IsWhitelabel,
-		ShardId:      event.ShardId,
-		Cache:        k.cache,
-		RateLimiter:  nil, // Use http-proxy ratelimit functionality
+	captureEvent(event)
+
+	ctx, span := startEventSpan(ctx, event)
+	defer span.End()
+
+	k.handleEvent(ctx, event)
+}
+
+func (k *KafkaConsumer) handleEvent(ctx context.Context, event eventforwarding.Event) {
+	workerCtx := newEventContext(ctx, event, k.cache, nil) // Use http-proxy ratelimit functionality
+
+	attempts, err := executeWithRetry(ctx, workerCtx, event.Event)
+	if err != nil {
+		k.logger.Error("Failed to handle event", zap.Error(err), zap.Int("attempts", attempts))
+
+		// Replaying an event that cannot be decoded would fail again, so there is no point dead-lettering it
+		if k.deadLetter != nil && !errors.Is(err, listeners.ErrInvalidEvent) {
+			k.publishDeadLetter(ctx, event, err, attempts)
+		}
 	}
+}
+
+// publishDeadLetter publishes the event to the dead-letter topic. ctx is cancelled when the worker shuts down, which is
+// also when retries are abandoned, so the event is published on a separate context that is not.
+func (k *KafkaConsumer) publishDeadLetter(ctx context.Context, event eventforwarding.Event, cause error, attempts int) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), deadLetterTimeout)
+	defer cancel()
 
-	if err := execute(workerCtx, event.Event); err != nil {
-		k.logger.Error("Failed to handle event", zap.Error(err))
+	if err := k.deadLetter.Publish(ctx, event, cause, attempts); err != nil {
+		k.logger.Error("Failed to publish event to dead-letter topic", zap.Error(err))
 	}
 }
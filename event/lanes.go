@@ -0,0 +1,91 @@
+package event
+
+import (
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/jadevelopmentgrp/Tickets-Worker/bot/metrics/prometheus"
+	"github.com/rxdn/gdl/gateway/payloads/events"
+)
+
+// eventLanes runs tasks sequentially per key, while tasks with different keys may run in parallel. Each key is
+// assigned to a fixed lane, a goroutine which processes its queue in order.
+type eventLanes struct {
+	lanes    []chan func()
+	wg       sync.WaitGroup
+	unkeyed  atomic.Uint64
+	shutdown sync.Once
+}
+
+func newEventLanes(count, bufferSize int) *eventLanes {
+	l := &eventLanes{
+		lanes: make([]chan func(), count),
+	}
+
+	for i := range l.lanes {
+		ch := make(chan func(), bufferSize)
+		l.lanes[i] = ch
+
+		depth := prometheus.EventLaneDepth.WithLabelValues(strconv.Itoa(i))
+
+		l.wg.Add(1)
+		go func() {
+			defer l.wg.Done()
+
+			for task := range ch {
+				depth.Dec()
+				task()
+			}
+		}()
+	}
+
+	return l
+}
+
+// Submit queues the task on the lane for the given key, blocking if the lane is full. A key of 0 means that the task
+// has no ordering requirements, so it is spread across the lanes.
+func (l *eventLanes) Submit(key uint64, task func()) {
+	if key == 0 {
+		key = l.unkeyed.Add(1)
+	}
+
+	lane := key % uint64(len(l.lanes))
+
+	prometheus.EventLaneDepth.WithLabelValues(strconv.FormatUint(lane, 10)).Inc()
+	l.lanes[lane] <- task
+}
+
+// Shutdown stops accepting tasks, and waits for all queued tasks to complete. Submit must not be called afterwards.
+func (l *eventLanes) Shutdown() {
+	l.shutdown.Do(func() {
+		for _, ch := range l.lanes {
+			close(ch)
+		}
+	})
+
+	l.wg.Wait()
+}
+
+type guildIdPayload struct {
+	EventName string `json:"t"`
+	Data      struct {
+		Id      uint64 `json:"id,string"`
+		GuildId uint64 `json:"guild_id,string"`
+	} `json:"d"`
+}
+
+// eventGuildId returns the ID of the guild a gateway payload relates to, or 0 if it does not relate to a guild.
+func eventGuildId(payload []byte) uint64 {
+	var data guildIdPayload
+	if err := json.Unmarshal(payload, &data); err != nil {
+		return 0
+	}
+
+	switch events.EventType(data.EventName) {
+	case events.GUILD_CREATE, events.GUILD_UPDATE, events.GUILD_DELETE:
+		return data.Data.Id
+	default:
+		return data.Data.GuildId
+	}
+}
@@ -0,0 +1,45 @@
+package event
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEventGuildId(t *testing.T) {
+	message := []byte(`{"op":0,"t":"MESSAGE_CREATE","d":{"id":"2","channel_id":"3","guild_id":"1"}}`)
+	require.Equal(t, uint64(1), eventGuildId(message))
+}
+
+func TestEventGuildIdGuildEvent(t *testing.T) {
+	message := []byte(`{"op":0,"t":"GUILD_CREATE","d":{"id":"1","name":"guild"}}`)
+	require.Equal(t, uint64(1), eventGuildId(message))
+}
+
+func TestEventGuildIdNoGuild(t *testing.T) {
+	message := []byte(`{"op":0,"t":"USER_UPDATE","d":{"id":"1","username":"user"}}`)
+	require.Equal(t, uint64(0), eventGuildId(message))
+}
+
+func TestLanesPreserveOrder(t *testing.T) {
+	lanes := newEventLanes(4, 10)
+
+	var mu sync.Mutex
+	handled := make(map[uint64][]int)
+
+	for i := 0; i < 100; i++ {
+		key := uint64(i%3 + 1)
+		lanes.Submit(key, func() {
+			mu.Lock()
+			defer mu.Unlock()
+			handled[key] = append(handled[key], i)
+		})
+	}
+
+	lanes.Shutdown()
+
+	for key, order := range handled {
+		require.IsIncreasing(t, order, "key %d", key)
+	}
+}
@@ -0,0 +1,111 @@
+package event
+
+import (
+	"sync"
+
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+// offsetTracker tracks the records polled from each partition that have not been handled yet. Records from the same
+// partition are handled out of order when they are on different lanes, so a record can only be committed once every
+// earlier record from its partition has also been handled.
+type offsetTracker struct {
+	mu         sync.Mutex
+	handled    *sync.Cond
+	partitions map[topicPartition][]*trackedRecord
+}
+
+type topicPartition struct {
+	topic     string
+	partition int32
+}
+
+type trackedRecord struct {
+	record *kgo.Record
+	done   bool
+}
+
+func newOffsetTracker() *offsetTracker {
+	t := &offsetTracker{
+		partitions: make(map[topicPartition][]*trackedRecord),
+	}
+
+	t.handled = sync.NewCond(&t.mu)
+	return t
+}
+
+// Add starts tracking a record. Records from each partition must be added in the order they were polled.
+func (t *offsetTracker) Add(record *kgo.Record) *trackedRecord {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tracked := &trackedRecord{record: record}
+
+	key := topicPartition{record.Topic, record.Partition}
+	t.partitions[key] = append(t.partitions[key], tracked)
+
+	return tracked
+}
+
+// Done marks the record as handled. Returns the latest record from its partition that can now be committed, or nil if
+// an earlier record is still being handled.
+func (t *offsetTracker) Done(tracked *trackedRecord) *kgo.Record {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	tracked.done = true
+
+	key := topicPartition{tracked.record.Topic, tracked.record.Partition}
+	records := t.partitions[key]
+
+	var committable *kgo.Record
+	for len(records) > 0 && records[0].done {
+		committable = records[0].record
+		records = records[1:]
+	}
+
+	if len(records) == 0 {
+		delete(t.partitions, key)
+	} else {
+		t.partitions[key] = records
+	}
+
+	t.handled.Broadcast()
+	return committable
+}
+
+// Wait blocks until every record added from the partitions has been handled
+func (t *offsetTracker) Wait(partitions map[string][]int32) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for t.pending(partitions) {
+		t.handled.Wait()
+	}
+}
+
+// Forget stops tracking the records added from the partitions
+func (t *offsetTracker) Forget(partitions map[string][]int32) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for topic, ids := range partitions {
+		for _, partition := range ids {
+			delete(t.partitions, topicPartition{topic, partition})
+		}
+	}
+
+	t.handled.Broadcast()
+}
+
+func (t *offsetTracker) pending(partitions map[string][]int32) bool {
+	for topic, ids := range partitions {
+		for _, partition := range ids {
+			if len(t.partitions[topicPartition{topic, partition}]) > 0 {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,59 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twmb/franz-go/pkg/kgo"
+)
+
+func TestOffsetTrackerCommitsInOrder(t *testing.T) {
+	tracker := newOffsetTracker()
+
+	first := tracker.Add(&kgo.Record{Topic: "events", Partition: 0, Offset: 1})
+	second := tracker.Add(&kgo.Record{Topic: "events", Partition: 0, Offset: 2})
+	third := tracker.Add(&kgo.Record{Topic: "events", Partition: 0, Offset: 3})
+
+	// The first record is still being handled, so nothing can be committed
+	require.Nil(t, tracker.Done(second))
+
+	committable := tracker.Done(first)
+	require.NotNil(t, committable)
+	require.Equal(t, int64(2), committable.Offset)
+
+	committable = tracker.Done(third)
+	require.NotNil(t, committable)
+	require.Equal(t, int64(3), committable.Offset)
+}
+
+func TestOffsetTrackerPartitionsAreIndependent(t *testing.T) {
+	tracker := newOffsetTracker()
+
+	tracker.Add(&kgo.Record{Topic: "events", Partition: 0, Offset: 1})
+	other := tracker.Add(&kgo.Record{Topic: "events", Partition: 1, Offset: 1})
+
+	committable := tracker.Done(other)
+	require.NotNil(t, committable)
+	require.Equal(t, int32(1), committable.Partition)
+}
+
+func TestOffsetTrackerWait(t *testing.T) {
+	tracker := newOffsetTracker()
+	tracked := tracker.Add(&kgo.Record{Topic: "events", Partition: 0, Offset: 1})
+
+	waited := make(chan struct{})
+	go func() {
+		tracker.Wait(map[string][]int32{"events": {0}})
+		close(waited)
+	}()
+
+	select {
+	case <-waited:
+		t.Fatal("Wait returned before the record was handled")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	tracker.Done(tracked)
+	<-waited
+}
@@ -0,0 +1,143 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jadevelopmentgrp/Tickets-Utilities/eventforwarding"
+	"github.com/twmb/franz-go/pkg/kgo"
+	"go.uber.org/zap"
+)
+
+// commitTimeout is how long to wait for the offsets of handled events to be committed when shutting down
+const commitTimeout = time.Second * 10
+
+// OrderedConsumer consumes gateway events from Kafka, handling events for the same guild in the order they were
+// produced, while events for different guilds are handled in parallel on the lanes. Records are polled by a single
+// goroutine, so they are submitted to the lanes in the order they were read from each partition. The offset of a
+// partition is only committed up to the last record before which every record has been handled, so that queued events
+// are not lost if the worker stops.
+type OrderedConsumer struct {
+	logger   *zap.Logger
+	client   *kgo.Client
+	listener *KafkaConsumer
+	lanes    *eventLanes
+	offsets  *offsetTracker
+}
+
+func NewOrderedConsumer(
+	logger *zap.Logger,
+	brokers []string,
+	group, topic string,
+	listener *KafkaConsumer,
+	laneCount, laneBufferSize int,
+) (*OrderedConsumer, error) {
+	c := &OrderedConsumer{
+		logger:   logger,
+		listener: listener,
+		lanes:    newEventLanes(laneCount, laneBufferSize),
+		offsets:  newOffsetTracker(),
+	}
+
+	client, err := kgo.NewClient(
+		kgo.SeedBrokers(brokers...),
+		kgo.ConsumerGroup(group),
+		kgo.ConsumeTopics(topic),
+		// A new group starts from the latest events, rather than every event still retained by the topic
+		kgo.ConsumeResetOffset(kgo.NewOffset().AtEnd()),
+		// Only records marked once handled are committed, see offsetTracker
+		kgo.AutoCommitMarks(),
+		// Partitions are only revoked between polls, once the records polled from them have been handled
+		kgo.BlockRebalanceOnPoll(),
+		kgo.OnPartitionsRevoked(c.onRevoked),
+		kgo.OnPartitionsLost(c.onLost),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	c.client = client
+	return c, nil
+}
+
+// Run consumes events until ctx is cancelled. The events already polled are handled, and their offsets committed,
+// before Run returns.
+func (c *OrderedConsumer) Run(ctx context.Context) {
+	defer c.client.Close()
+
+	for {
+		fetches := c.client.PollFetches(ctx)
+		if fetches.IsClientClosed() {
+			break
+		}
+
+		fetches.EachError(func(topic string, partition int32, err error) {
+			if !errors.Is(err, context.Canceled) {
+				c.logger.Error("Failed to fetch events", zap.String("topic", topic), zap.Int32("partition", partition), zap.Error(err))
+			}
+		})
+
+		fetches.EachRecord(c.submit)
+
+		c.client.AllowRebalance()
+
+		if ctx.Err() != nil {
+			break
+		}
+	}
+
+	c.lanes.Shutdown()
+
+	// Use a new context, so that the offsets of the events that were handled are still committed when shutting down
+	commitCtx, cancel := context.WithTimeout(context.Background(), commitTimeout)
+	defer cancel()
+
+	if err := c.client.CommitMarkedOffsets(commitCtx); err != nil {
+		c.logger.Error("Failed to commit offsets", zap.Error(err))
+	}
+}
+
+func (c *OrderedConsumer) submit(record *kgo.Record) {
+	tracked := c.offsets.Add(record)
+
+	var event eventforwarding.Event
+	if err := json.Unmarshal(record.Value, &event); err != nil {
+		c.logger.Error("Failed to unmarshal event", zap.Error(err))
+		c.markHandled(tracked)
+		return
+	}
+
+	captureEvent(event)
+
+	// Time spent waiting on a lane is included in the span
+	spanCtx, span := startEventSpan(context.Background(), event)
+
+	c.lanes.Submit(eventGuildId(event.Event), func() {
+		defer c.markHandled(tracked)
+		defer span.End()
+
+		c.listener.handleEvent(spanCtx, event)
+	})
+}
+
+func (c *OrderedConsumer) markHandled(tracked *trackedRecord) {
+	if committable := c.offsets.Done(tracked); committable != nil {
+		c.client.MarkCommitRecords(committable)
+	}
+}
+
+// onRevoked waits for the records already polled from the revoked partitions to be handled, and commits them, so that
+// the member the partitions are assigned to next does not handle them again.
+func (c *OrderedConsumer) onRevoked(ctx context.Context, client *kgo.Client, revoked map[string][]int32) {
+	c.offsets.Wait(revoked)
+
+	if err := client.CommitMarkedOffsets(ctx); err != nil {
+		c.logger.Error("Failed to commit offsets of revoked partitions", zap.Error(err))
+	}
+}
+
+// onLost stops tracking the records polled from the lost partitions, as their offsets can no longer be committed
+func (c *OrderedConsumer) onLost(_ context.Context, _ *kgo.Client, lost map[string][]int32) {
+	c.offsets.Forget(lost)
+}
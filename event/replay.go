@@ -0,0 +1,56 @@
+package event
+
+import (
+	"bytes"
+	"fmt"
+	"net/http/httptest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/rxdn/gdl/cache"
+)
+
+// replaying is set when payloads are being fed back from a capture file, rather than received live
+var replaying bool
+
+// Replayer feeds captured payloads back through the same handlers used for live events and interactions.
+type Replayer struct {
+	router *gin.Engine
+}
+
+func NewReplayer(redis *redis.Client, cache *cache.PgCache) *Replayer {
+	replaying = true
+
+	router := gin.New()
+	router.POST("/event", eventHandler(cache))
+	router.POST("/interaction", interactionHandler(redis, cache))
+
+	return &Replayer{
+		router: router,
+	}
+}
+
+// Replay handles the captured payload, and waits for any work started in the background to complete. Returns the
+// status code and body of the response that would have been sent.
+func (r *Replayer) Replay(captured CapturedPayload) (int, []byte, error) {
+	var path string
+	switch captured.Kind {
+	case CaptureKindEvent:
+		path = "/event"
+	case CaptureKindInteraction:
+		path = "/interaction"
+	default:
+		return 0, nil, fmt.Errorf("unknown capture kind: %s", captured.Kind)
+	}
+
+	req := httptest.NewRequest("POST", path, bytes.NewReader(captured.Payload))
+	req.Header.Set("Content-Type", "application/json")
+
+	recorder := httptest.NewRecorder()
+	r.router.ServeHTTP(recorder, req)
+
+	// Wait for the command or component handler to finish sending its responses
+	inFlight.Wait()
+
+	return recorder.Code, recorder.Body.Bytes(), nil
+}
@@ -0,0 +1,128 @@
+package event
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/jadevelopmentgrp/Tickets-Utilities/eventforwarding"
+	"github.com/jadevelopmentgrp/Tickets-Worker/config"
+	"github.com/rxdn/gdl/cache"
+	"github.com/rxdn/gdl/gateway"
+	"github.com/rxdn/gdl/gateway/intents"
+	"github.com/rxdn/gdl/gateway/payloads"
+	"github.com/rxdn/gdl/gateway/payloads/events"
+	"github.com/rxdn/gdl/rest/ratelimit"
+	"go.uber.org/zap"
+)
+
+// StandaloneGateway connects to the Discord gateway directly, rather than consuming events forwarded over Kafka by the
+// gateway service. Only the public bot is supported.
+type StandaloneGateway struct {
+	logger  *zap.Logger
+	cache   *cache.PgCache
+	manager *gateway.ShardManager
+}
+
+var shardType = reflect.TypeOf((*gateway.Shard)(nil))
+
+func NewStandaloneGateway(logger *zap.Logger, redis *redis.Client, pgCache *cache.PgCache) *StandaloneGateway {
+	shardCount := max(config.Conf.Standalone.ShardCount, 1)
+
+	shardOptions := gateway.ShardOptions{
+		ShardCount: gateway.ShardCount{
+			Total:   shardCount,
+			Lowest:  0,
+			Highest: shardCount,
+		},
+		// Share the worker's cache, so that the gdl cache listeners populate it in place of the gateway service
+		CacheFactory: func() cache.Cache {
+			return pgCache
+		},
+		RateLimitStore:     ratelimit.NewRedisStore(redis, "ratelimiter:standalone"),
+		GuildSubscriptions: false,
+		Intents: []intents.Intent{
+			intents.Guilds,
+			intents.GuildMembers,
+			intents.GuildMessages,
+		},
+	}
+
+	g := &StandaloneGateway{
+		logger:  logger,
+		cache:   pgCache,
+		manager: gateway.NewShardManager(config.Conf.Discord.Token, shardOptions),
+	}
+
+	g.registerListeners()
+
+	return g
+}
+
+func (g *StandaloneGateway) Connect() {
+	g.manager.Connect()
+}
+
+func (g *StandaloneGateway) Shutdown() {
+	for _, shard := range g.manager.Shards {
+		if err := shard.Kill(); err != nil {
+			g.logger.Warn("Failed to close shard", zap.Int("shard_id", shard.ShardId), zap.Error(err))
+		}
+	}
+}
+
+// registerListeners registers a listener on the gdl event bus for every event type. gdl only dispatches events to
+// listeners of type func(*gateway.Shard, *events.X), so one is built for each type using reflection.
+func (g *StandaloneGateway) registerListeners() {
+	for eventType, dataType := range events.EventTypes {
+		fnType := reflect.FuncOf([]reflect.Type{shardType, reflect.PointerTo(dataType)}, nil, false)
+
+		listener := reflect.MakeFunc(fnType, func(args []reflect.Value) []reflect.Value {
+			g.dispatch(args[0].Interface().(*gateway.Shard), eventType, args[1].Interface())
+			return nil
+		})
+
+		g.manager.RegisterListeners(listener.Interface())
+	}
+}
+
+func (g *StandaloneGateway) dispatch(shard *gateway.Shard, eventType events.EventType, data any) {
+	marshalled, err := json.Marshal(data)
+	if err != nil {
+		g.logger.Error("Failed to marshal event", zap.String("event_type", string(eventType)), zap.Error(err))
+		return
+	}
+
+	payload, err := json.Marshal(payloads.Payload{
+		Opcode:    0, // Dispatch
+		Data:      marshalled,
+		EventName: string(eventType),
+	})
+	if err != nil {
+		g.logger.Error("Failed to marshal payload", zap.String("event_type", string(eventType)), zap.Error(err))
+		return
+	}
+
+	event := eventforwarding.Event{
+		BotToken:     shard.Token,
+		BotId:        config.Conf.Discord.PublicBotId,
+		IsWhitelabel: false,
+		ShardId:      shard.ShardId,
+		Event:        payload,
+	}
+
+	captureEvent(event)
+
+	// If requests are not sent through the http-proxy, we must handle ratelimits ourselves
+	var rateLimiter *ratelimit.Ratelimiter
+	if config.Conf.Discord.ProxyUrl == "" {
+		rateLimiter = g.manager.RateLimiter
+	}
+
+	ctx, span := startEventSpan(context.Background(), event)
+	defer span.End()
+
+	if err := execute(newEventContext(ctx, event, g.cache, rateLimiter), event.Event, nil); err != nil {
+		g.logger.Error("Failed to handle event", zap.String("event_type", string(eventType)), zap.Error(err))
+	}
+}
@@ -70,6 +70,27 @@ func GetMessage(locale *Locale, id MessageId, format ...interface{}) string {
 	return fmt.Sprintf(strings.Replace(value, "\\n", "\n", -1), format...)
 }
 
+// GetLocalisations returns the translations of the message into each language that Discord supports, keyed by the
+// Discord locale. English is the default language, so it is not included, and neither are languages that the message
+// has not been translated into.
+func GetLocalisations(id MessageId) map[string]string {
+	localisations := make(map[string]string)
+	for _, locale := range Locales {
+		if locale == LocaleEnglish || locale.DiscordLocale == nil {
+			continue
+		}
+
+		value, ok := locale.Messages[id]
+		if !ok || value == "" {
+			continue
+		}
+
+		localisations[*locale.DiscordLocale] = strings.Replace(value, "\\n", "\n", -1)
+	}
+
+	return localisations
+}
+
 func GetMessageFromGuild(guildId uint64, id MessageId, format ...interface{}) string {
 	// TODO: Propagate context
 	activeLanguage, err := dbclient.Client.ActiveLanguage.Get(context.Background(), guildId)
@@ -10,6 +10,9 @@ func (m MessageId) GetFromGuild(guildId uint64, format ...interface{}) string {
 var (
 	MessageNoPermission MessageId = "generic.no_permission"
 	MessageOwnerOnly    MessageId = "generic.owner_only"
+	MessageCooldown     MessageId = "generic.cooldown"
+
+	MessageCommandsUpdating MessageId = "generic.commands_updating"
 
 	Error     MessageId = "generic.error"
 	Success   MessageId = "generic.success"
@@ -49,6 +52,12 @@ var (
 	TitlePanelSwitched     MessageId = "generic.title.panel_switched"
 	TitleJumpToTop         MessageId = "generic.title.jump_to_top"
 	TitleReopened          MessageId = "generic.title.reopened"
+	TitleCommandsUpdating  MessageId = "generic.title.commands_updating"
+	TitlePriority          MessageId = "generic.title.priority"
+	TitleSlaBreached       MessageId = "generic.title.sla_breached"
+	TitleMerge             MessageId = "generic.title.merge"
+	TitleOnHold            MessageId = "generic.title.on_hold"
+	TitleResumed           MessageId = "generic.title.resumed"
 
 	MessageAbout MessageId = "commands.about"
 
@@ -76,6 +85,10 @@ var (
 	MessageTagCreateLimit            MessageId = "commands.tags.create.limit"
 	MessageTagCreateSuccess          MessageId = "commands.tags.create.success"
 
+	MessageSaveAsTagEmpty        MessageId = "commands.save_as_tag.empty"
+	MessageSaveAsTagIdLabel      MessageId = "commands.save_as_tag.id_label"
+	MessageSaveAsTagContentLabel MessageId = "commands.save_as_tag.content_label"
+
 	MessageTagDeleteInvalidArguments MessageId = "commands.tags.delete.invalid_arguments"
 	MessageTagDeleteDoesNotExist     MessageId = "commands.tags.delete.not_exist"
 	MessageTagDeleteSuccess          MessageId = "commands.tags.delete.success"
@@ -160,12 +173,22 @@ var (
 	MessageOpenCantSeeParentChannel MessageId = "commands.open.threads.cant_see_parent_channel"
 	MessageOpenCantMessageInThreads MessageId = "commands.open.threads.cant_message_in_threads"
 
+	MessageOpenForUserBot         MessageId = "commands.open_for_user.bot"
+	MessageOpenForUserBlacklisted MessageId = "commands.open_for_user.blacklisted"
+	MessageOpenedForUser          MessageId = "commands.open_for_user.opened"
+
+	MessageViewTicketsDescription MessageId = "commands.view_tickets.description"
+	MessageViewTicketsOpen        MessageId = "commands.view_tickets.open"
+	MessageViewTicketsClosed      MessageId = "commands.view_tickets.closed"
+	MessageViewTicketsNone        MessageId = "commands.view_tickets.none"
+
 	MessageCloseRequestNoReason     MessageId = "commands.close_request.no_reason"
 	MessageCloseRequestWithReason   MessageId = "commands.close_request.with_reason"
 	MessageCloseRequestNoPermission MessageId = "commands.close_request.no_permission"
 	MessageCloseRequestDenied       MessageId = "commands.close_request.denied"
 	MessageCloseRequestAccept       MessageId = "commands.close_request.accept"
 	MessageCloseRequestDeny         MessageId = "commands.close_request.deny"
+	MessageCloseRequestInvalidDelay MessageId = "commands.close_request.invalid_delay"
 
 	MessageSwitchPanelInvalidPanel MessageId = "commands.switch_panel.invalid_panel"
 	MessageSwitchPanelSuccess      MessageId = "commands.switch_panel.success"
@@ -197,6 +220,39 @@ var (
 	MessageNotesThreadName      MessageId = "commands.notes.thread_name"
 	MessageNotesAddedToExisting MessageId = "commands.notes.added_to_existing"
 	MessageNotesCreated         MessageId = "commands.notes.created"
+	MessageNotesMessageAdded    MessageId = "commands.notes.message_added"
+	MessageNotesAddedFrom       MessageId = "commands.notes.added_from"
+
+	MessagePriorityInvalid MessageId = "commands.priority.invalid"
+	MessagePrioritySuccess MessageId = "commands.priority.success"
+
+	PriorityLow    MessageId = "priority.low"
+	PriorityNormal MessageId = "priority.normal"
+	PriorityHigh   MessageId = "priority.high"
+	PriorityUrgent MessageId = "priority.urgent"
+
+	MessageSlaFirstResponseBreached MessageId = "sla.first_response_breached"
+	MessageSlaNextResponseBreached  MessageId = "sla.next_response_breached"
+
+	MessageTicketAutoAssigned MessageId = "ticket.auto_assigned"
+
+	MessageMergeSameTicket     MessageId = "commands.merge.same_ticket"
+	MessageMergeTicketNotFound MessageId = "commands.merge.not_found"
+	MessageMergeSuccess        MessageId = "commands.merge.success"
+	MessageMergeMembersAdded   MessageId = "commands.merge.members_added"
+	MessageMergeTranscript     MessageId = "commands.merge.transcript"
+	MessageMergedInto          MessageId = "commands.merge.merged_into"
+	MessageMergeCloseReason    MessageId = "commands.merge.close_reason"
+	MessageMergeCloseFailed    MessageId = "commands.merge.close_failed"
+
+	MessageHoldNoReason      MessageId = "commands.hold.no_reason"
+	MessageHoldWithReason    MessageId = "commands.hold.with_reason"
+	MessageHoldResumes       MessageId = "commands.hold.resumes"
+	MessageHoldInvalidHours  MessageId = "commands.hold.invalid_hours"
+	MessageHoldReasonTooLong MessageId = "commands.hold.reason_too_long"
+	MessageUnholdNotOnHold   MessageId = "commands.unhold.not_on_hold"
+	MessageUnholdSuccess     MessageId = "commands.unhold.success"
+	MessageHoldAutoResumed   MessageId = "commands.hold.auto_resumed"
 
 	MessageJoinClosedTicket       MessageId = "button.join_thread.closed_ticket"
 	MessageJoinThreadNoPermission MessageId = "button.join_thread.no_permission"
@@ -226,8 +282,42 @@ var (
 	SetupThreadsSuccess                 MessageId = "setup.threads.success"
 	SetupThreadsDisabled                MessageId = "setup.threads.disabled"
 
+	SetupPermissionUnknownCommand MessageId = "setup.permission.unknown_command"
+	SetupPermissionInvalidLevel   MessageId = "setup.permission.invalid_level"
+	SetupPermissionComplete       MessageId = "setup.permission.success"
+	SetupPermissionReset          MessageId = "setup.permission.reset"
+
+	SetupPanelInvalid MessageId = "setup.panel.invalid"
+
+	SetupPriorityCategoryType  MessageId = "setup.priority.category_type"
+	SetupPriorityComplete      MessageId = "setup.priority.success"
+	SetupPanelPriorityComplete MessageId = "setup.panel_priority.success"
+
+	SetupPanelSlaInvalidTarget MessageId = "setup.panel_sla.invalid_target"
+	SetupPanelSlaComplete      MessageId = "setup.panel_sla.success"
+	SetupPanelSlaRemoved       MessageId = "setup.panel_sla.removed"
+
+	SetupAutoAssignInvalidMode MessageId = "setup.auto_assign.invalid_mode"
+	SetupAutoAssignComplete    MessageId = "setup.auto_assign.success"
+	SetupAutoAssignDisabled    MessageId = "setup.auto_assign.disabled"
+
+	SetupOnHoldCategoryType     MessageId = "setup.on_hold_category.category_type"
+	SetupOnHoldCategoryComplete MessageId = "setup.on_hold_category.success"
+	SetupOnHoldCategoryRemoved  MessageId = "setup.on_hold_category.removed"
+
 	MessageOwnerIsAlreadyAdmin MessageId = "commands.addadmin.owner"
 	MessageHelpInvite          MessageId = "help.invite"
+	MessageHelpSupportOnly     MessageId = "commands.help.support_only"
+	MessageHelpAdminOnly       MessageId = "commands.help.admin_only"
+	MessageHelpEveryone        MessageId = "commands.help.everyone"
+	MessageHelpUnknownCommand  MessageId = "commands.help.unknown_command"
+	MessageHelpArguments       MessageId = "commands.help.arguments"
+	MessageHelpSubcommands     MessageId = "commands.help.subcommands"
+	MessageHelpPermission      MessageId = "commands.help.permission"
+	MessageHelpCooldown        MessageId = "commands.help.cooldown"
+	MessageHelpCooldownUser    MessageId = "commands.help.cooldown.user"
+	MessageHelpCooldownChannel MessageId = "commands.help.cooldown.channel"
+	MessageHelpCooldownGuild   MessageId = "commands.help.cooldown.guild"
 	MessageInvite              MessageId = "commands.invite"
 
 	MessageFeedbackDisabled MessageId = "feedback.disabled"
@@ -274,4 +364,56 @@ var (
 	HelpSwitchPanel        MessageId = "help.switch_panel"
 	HelpJumpToTop          MessageId = "help.jump_to_top"
 	HelpOnCall             MessageId = "help.on_call"
+	HelpPriority           MessageId = "help.priority"
+	HelpMerge              MessageId = "help.merge"
+	HelpHold               MessageId = "help.hold"
+	HelpUnhold             MessageId = "help.unhold"
+
+	HelpLongCloseRequest MessageId = "help.long.close_request"
+	HelpLongNotes        MessageId = "help.long.notes"
+	HelpLongOnCall       MessageId = "help.long.on_call"
+	HelpLongSwitchPanel  MessageId = "help.long.switch_panel"
+
+	ArgumentAddUser                         MessageId = "arguments.add.user"
+	ArgumentAddAdminUserOrRole              MessageId = "arguments.addadmin.user_or_role"
+	ArgumentAddSupportRole                  MessageId = "arguments.addsupport.role"
+	ArgumentBlacklistUserOrRole             MessageId = "arguments.blacklist.user_or_role"
+	ArgumentCloseReason                     MessageId = "arguments.close.reason"
+	ArgumentCloseRequestCloseDelay          MessageId = "arguments.closerequest.close_delay"
+	ArgumentHelpCommand                     MessageId = "arguments.help.command"
+	ArgumentHoldReason                      MessageId = "arguments.hold.reason"
+	ArgumentHoldHours                       MessageId = "arguments.hold.hours"
+	ArgumentMergeTicket                     MessageId = "arguments.merge.ticket"
+	ArgumentOpenSubject                     MessageId = "arguments.open.subject"
+	ArgumentPriorityPriority                MessageId = "arguments.priority.priority"
+	ArgumentRemoveUser                      MessageId = "arguments.remove.user"
+	ArgumentRemoveAdminUserOrRole           MessageId = "arguments.removeadmin.user_or_role"
+	ArgumentRemoveSupportUserOrRole         MessageId = "arguments.removesupport.user_or_role"
+	ArgumentRenameName                      MessageId = "arguments.rename.name"
+	ArgumentReopenTicketId                  MessageId = "arguments.reopen.ticket_id"
+	ArgumentSetupAutoAssignMode             MessageId = "arguments.setup.auto_assign.mode"
+	ArgumentSetupLimitLimit                 MessageId = "arguments.setup.limit.limit"
+	ArgumentSetupOnHoldCategory             MessageId = "arguments.setup.on_hold_category.category"
+	ArgumentSetupPermissionCommand          MessageId = "arguments.setup.permission.command"
+	ArgumentSetupPermissionLevel            MessageId = "arguments.setup.permission.level"
+	ArgumentSetupPermissionRole             MessageId = "arguments.setup.permission.role"
+	ArgumentSetupPriorityChannelPrefix      MessageId = "arguments.setup.priority.channel_prefix"
+	ArgumentSetupPriorityCategory           MessageId = "arguments.setup.priority.high_priority_category"
+	ArgumentSetupPriorityUrgentPingRole     MessageId = "arguments.setup.priority.urgent_ping_role"
+	ArgumentSetupPanelPriorityPanel         MessageId = "arguments.setup.panel_priority.panel"
+	ArgumentSetupPanelPriorityPriority      MessageId = "arguments.setup.panel_priority.priority"
+	ArgumentSetupPanelSlaPanel              MessageId = "arguments.setup.panel_sla.panel"
+	ArgumentSetupPanelSlaFirstResponse      MessageId = "arguments.setup.panel_sla.first_response"
+	ArgumentSetupPanelSlaNextResponse       MessageId = "arguments.setup.panel_sla.next_response"
+	ArgumentSetupPanelSlaEscalationRole     MessageId = "arguments.setup.panel_sla.escalation_role"
+	ArgumentSetupThreadsUseThreads          MessageId = "arguments.setup.threads.use_threads"
+	ArgumentSetupThreadsNotificationChannel MessageId = "arguments.setup.threads.ticket_notification_channel"
+	ArgumentSetupTranscriptsChannel         MessageId = "arguments.setup.transcripts.channel"
+	ArgumentStatsUserUser                   MessageId = "arguments.stats.user.user"
+	ArgumentSwitchPanelPanel                MessageId = "arguments.switchpanel.panel"
+	ArgumentTagId                           MessageId = "arguments.tag.id"
+	ArgumentTagAddId                        MessageId = "arguments.managetags.add.id"
+	ArgumentTagAddContent                   MessageId = "arguments.managetags.add.content"
+	ArgumentTagDeleteId                     MessageId = "arguments.managetags.delete.id"
+	ArgumentTransferUser                    MessageId = "arguments.transfer.user"
 )
@@ -21,7 +21,7 @@ import (
 func (ctx *Context) GetChannel(channelId uint64) (channel.Channel, error) {
 	shouldCache := ctx.Cache.Options().Channels
 	if shouldCache {
-		cached, err := ctx.Cache.GetChannel(context.Background(), channelId)
+		cached, err := ctx.Cache.GetChannel(ctx.BaseContext(), channelId)
 		if err == nil {
 			return cached, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -29,7 +29,7 @@ func (ctx *Context) GetChannel(channelId uint64) (channel.Channel, error) {
 		} // else, continue
 	}
 
-	channel, err := rest.GetChannel(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	channel, err := rest.GetChannel(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 
 	if shouldCache && err == nil {
 		go ctx.Cache.StoreChannel(context.Background(), channel)
@@ -39,7 +39,7 @@ func (ctx *Context) GetChannel(channelId uint64) (channel.Channel, error) {
 }
 
 func (ctx *Context) ModifyChannel(channelId uint64, data rest.ModifyChannelData) (channel.Channel, error) {
-	channel, err := rest.ModifyChannel(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	channel, err := rest.ModifyChannel(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 
 	if ctx.Cache.Options().Channels && err != nil {
 		go ctx.Cache.StoreChannel(context.Background(), channel)
@@ -49,15 +49,15 @@ func (ctx *Context) ModifyChannel(channelId uint64, data rest.ModifyChannelData)
 }
 
 func (ctx *Context) DeleteChannel(channelId uint64) (channel.Channel, error) {
-	return rest.DeleteChannel(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.DeleteChannel(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) GetChannelMessages(channelId uint64, options rest.GetChannelMessagesData) ([]message.Message, error) {
-	return rest.GetChannelMessages(context.Background(), ctx.Token, ctx.RateLimiter, channelId, options)
+	return rest.GetChannelMessages(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, options)
 }
 
 func (ctx *Context) GetChannelMessage(channelId, messageId uint64) (message.Message, error) {
-	return rest.GetChannelMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	return rest.GetChannelMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) CreateMessage(channelId uint64, content string) (message.Message, error) {
@@ -87,123 +87,123 @@ func (ctx *Context) CreateMessageEmbedReply(channelId uint64, e *embed.Embed, re
 }
 
 func (ctx *Context) CreateMessageComplex(channelId uint64, data rest.CreateMessageData) (message.Message, error) {
-	return rest.CreateMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.CreateMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) CreateReaction(channelId, messageId uint64, emoji string) error {
-	return rest.CreateReaction(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
+	return rest.CreateReaction(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
 }
 
 func (ctx *Context) DeleteOwnReaction(channelId, messageId uint64, emoji string) error {
-	return rest.DeleteOwnReaction(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
+	return rest.DeleteOwnReaction(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
 }
 
 func (ctx *Context) DeleteUserReaction(channelId, messageId, userId uint64, emoji string) error {
-	return rest.DeleteUserReaction(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, userId, emoji)
+	return rest.DeleteUserReaction(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId, userId, emoji)
 }
 
 func (ctx *Context) GetReactions(channelId, messageId uint64, emoji string, options rest.GetReactionsData) ([]user.User, error) {
-	return rest.GetReactions(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji, options)
+	return rest.GetReactions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji, options)
 }
 
 func (ctx *Context) DeleteAllReactions(channelId, messageId uint64) error {
-	return rest.DeleteAllReactions(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	return rest.DeleteAllReactions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) DeleteAllReactionsEmoji(channelId, messageId uint64, emoji string) error {
-	return rest.DeleteAllReactionsEmoji(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
+	return rest.DeleteAllReactionsEmoji(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId, emoji)
 }
 
 func (ctx *Context) EditMessage(channelId, messageId uint64, data rest.EditMessageData) (message.Message, error) {
-	return rest.EditMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, data)
+	return rest.EditMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId, data)
 }
 
 func (ctx *Context) DeleteMessage(channelId, messageId uint64) error {
-	return rest.DeleteMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	return rest.DeleteMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) BulkDeleteMessages(channelId uint64, messages []uint64) error {
-	return rest.BulkDeleteMessages(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messages)
+	return rest.BulkDeleteMessages(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messages)
 }
 
 func (ctx *Context) EditChannelPermissions(channelId uint64, updated channel.PermissionOverwrite) error {
-	return rest.EditChannelPermissions(context.Background(), ctx.Token, ctx.RateLimiter, channelId, updated)
+	return rest.EditChannelPermissions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, updated)
 }
 
 func (ctx *Context) GetChannelInvites(channelId uint64) ([]invite.InviteMetadata, error) {
-	return rest.GetChannelInvites(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.GetChannelInvites(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) CreateChannelInvite(channelId uint64, data rest.CreateInviteData) (invite.Invite, error) {
-	return rest.CreateChannelInvite(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.CreateChannelInvite(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) DeleteChannelPermissions(channelId, overwriteId uint64) error {
-	return rest.DeleteChannelPermissions(context.Background(), ctx.Token, ctx.RateLimiter, channelId, overwriteId)
+	return rest.DeleteChannelPermissions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, overwriteId)
 }
 
 func (ctx *Context) TriggerTypingIndicator(channelId uint64) error {
-	return rest.TriggerTypingIndicator(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.TriggerTypingIndicator(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) GetPinnedMessages(channelId uint64) ([]message.Message, error) {
-	return rest.GetPinnedMessages(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.GetPinnedMessages(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) AddPinnedChannelMessage(channelId, messageId uint64) error {
-	return rest.AddPinnedChannelMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	return rest.AddPinnedChannelMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) DeletePinnedChannelMessage(channelId, messageId uint64) error {
-	return rest.DeletePinnedChannelMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId)
+	return rest.DeletePinnedChannelMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId)
 }
 
 func (ctx *Context) JoinThread(channelId uint64) error {
-	return rest.JoinThread(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.JoinThread(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) AddThreadMember(channelId, userId uint64) error {
-	return rest.AddThreadMember(context.Background(), ctx.Token, ctx.RateLimiter, channelId, userId)
+	return rest.AddThreadMember(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, userId)
 }
 
 func (ctx *Context) LeaveThread(channelId uint64) error {
-	return rest.LeaveThread(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.LeaveThread(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) RemoveThreadMember(channelId, userId uint64) error {
-	return rest.RemoveThreadMember(context.Background(), ctx.Token, ctx.RateLimiter, channelId, userId)
+	return rest.RemoveThreadMember(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, userId)
 }
 
 func (ctx *Context) GetThreadMember(channelId, userId uint64) (channel.ThreadMember, error) {
-	return rest.GetThreadMember(context.Background(), ctx.Token, ctx.RateLimiter, channelId, userId)
+	return rest.GetThreadMember(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, userId)
 }
 
 func (ctx *Context) ListThreadMembers(channelId uint64) ([]channel.ThreadMember, error) {
-	return rest.ListThreadMembers(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.ListThreadMembers(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) ListActiveThreads(channelId uint64) (rest.ThreadsResponse, error) {
-	return rest.ListActiveThreads(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.ListActiveThreads(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) ListPublicArchivedThreads(channelId uint64, data rest.ListThreadsData) (rest.ThreadsResponse, error) {
-	return rest.ListPublicArchivedThreads(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.ListPublicArchivedThreads(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) ListPrivateArchivedThreads(channelId uint64, data rest.ListThreadsData) (rest.ThreadsResponse, error) {
-	return rest.ListPrivateArchivedThreads(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.ListPrivateArchivedThreads(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) ListJoinedPrivateArchivedThreads(channelId uint64, data rest.ListThreadsData) (rest.ThreadsResponse, error) {
-	return rest.ListPrivateArchivedThreads(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.ListPrivateArchivedThreads(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) StartThreadWithMessage(channelId, messageId uint64, data rest.StartThreadWithMessageData) (channel.Channel, error) {
-	return rest.StartThreadWithMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, messageId, data)
+	return rest.StartThreadWithMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, messageId, data)
 }
 
 func (ctx *Context) StartThreadWithoutMessage(channelId, messageId uint64, data rest.StartThreadWithoutMessageData) (channel.Channel, error) {
-	return rest.StartThreadWithoutMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.StartThreadWithoutMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) CreatePublicThread(channelId uint64, name string, autoArchiveDuration uint16) (channel.Channel, error) {
@@ -213,7 +213,7 @@ func (ctx *Context) CreatePublicThread(channelId uint64, name string, autoArchiv
 		Type:                channel.ChannelTypeGuildPublicThread,
 	}
 
-	return rest.StartThreadWithoutMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.StartThreadWithoutMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) CreatePrivateThread(channelId uint64, name string, autoArchiveDuration uint16, invitable bool) (channel.Channel, error) {
@@ -224,7 +224,7 @@ func (ctx *Context) CreatePrivateThread(channelId uint64, name string, autoArchi
 		Invitable:           invitable,
 	}
 
-	return rest.StartThreadWithoutMessage(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.StartThreadWithoutMessage(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) ListGuildEmojis(guildId uint64) ([]emoji.Emoji, error) {
@@ -232,7 +232,7 @@ func (ctx *Context) ListGuildEmojis(guildId uint64) ([]emoji.Emoji, error) {
 	shouldCacheGuild := ctx.Cache.Options().Guilds
 
 	if shouldCacheEmoji && shouldCacheGuild {
-		guild, err := ctx.Cache.GetGuild(context.Background(), guildId)
+		guild, err := ctx.Cache.GetGuild(ctx.BaseContext(), guildId)
 		if err == nil {
 			return guild.Emojis, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -240,7 +240,7 @@ func (ctx *Context) ListGuildEmojis(guildId uint64) ([]emoji.Emoji, error) {
 		} // else, continue
 	}
 
-	emojis, err := rest.ListGuildEmojis(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	emojis, err := rest.ListGuildEmojis(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 
 	if shouldCacheEmoji && err == nil {
 		go ctx.Cache.StoreEmojis(context.Background(), emojis, guildId)
@@ -252,7 +252,7 @@ func (ctx *Context) ListGuildEmojis(guildId uint64) ([]emoji.Emoji, error) {
 func (ctx *Context) GetGuildEmoji(guildId uint64, emojiId uint64) (emoji.Emoji, error) {
 	shouldCache := ctx.Cache.Options().Emojis
 	if shouldCache {
-		e, err := ctx.Cache.GetEmoji(context.Background(), emojiId)
+		e, err := ctx.Cache.GetEmoji(ctx.BaseContext(), emojiId)
 		if err == nil {
 			return e, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -260,7 +260,7 @@ func (ctx *Context) GetGuildEmoji(guildId uint64, emojiId uint64) (emoji.Emoji,
 		} // else, continue
 	}
 
-	emoji, err := rest.GetGuildEmoji(context.Background(), ctx.Token, ctx.RateLimiter, guildId, emojiId)
+	emoji, err := rest.GetGuildEmoji(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, emojiId)
 
 	if shouldCache && err == nil {
 		go ctx.Cache.StoreEmoji(context.Background(), emoji, guildId)
@@ -270,23 +270,23 @@ func (ctx *Context) GetGuildEmoji(guildId uint64, emojiId uint64) (emoji.Emoji,
 }
 
 func (ctx *Context) CreateGuildEmoji(guildId uint64, data rest.CreateEmojiData) (emoji.Emoji, error) {
-	return rest.CreateGuildEmoji(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	return rest.CreateGuildEmoji(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 // updating Image is not permitted
 func (ctx *Context) ModifyGuildEmoji(guildId, emojiId uint64, data rest.CreateEmojiData) (emoji.Emoji, error) {
-	return rest.ModifyGuildEmoji(context.Background(), ctx.Token, ctx.RateLimiter, guildId, emojiId, data)
+	return rest.ModifyGuildEmoji(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, emojiId, data)
 }
 
 func (ctx *Context) CreateGuild(data rest.CreateGuildData) (guild.Guild, error) {
-	return rest.CreateGuild(context.Background(), ctx.Token, data)
+	return rest.CreateGuild(ctx.BaseContext(), ctx.Token, data)
 }
 
 func (ctx *Context) GetGuild(guildId uint64) (guild.Guild, error) {
 	shouldCache := ctx.Cache.Options().Guilds
 
 	if shouldCache {
-		cachedGuild, err := ctx.Cache.GetGuild(context.Background(), guildId)
+		cachedGuild, err := ctx.Cache.GetGuild(ctx.BaseContext(), guildId)
 		if err == nil {
 			return cachedGuild, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -294,7 +294,7 @@ func (ctx *Context) GetGuild(guildId uint64) (guild.Guild, error) {
 		} // else, continue
 	}
 
-	guild, err := rest.GetGuild(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	guild, err := rest.GetGuild(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 	if err == nil {
 		go ctx.Cache.StoreGuild(context.Background(), guild)
 	}
@@ -303,22 +303,22 @@ func (ctx *Context) GetGuild(guildId uint64) (guild.Guild, error) {
 }
 
 func (ctx *Context) GetGuildPreview(guildId uint64) (guild.GuildPreview, error) {
-	return rest.GetGuildPreview(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.GetGuildPreview(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) ModifyGuild(guildId uint64, data rest.ModifyGuildData) (guild.Guild, error) {
-	return rest.ModifyGuild(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	return rest.ModifyGuild(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) DeleteGuild(guildId uint64) error {
-	return rest.DeleteGuild(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.DeleteGuild(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetGuildChannels(guildId uint64) ([]channel.Channel, error) {
 	shouldCache := ctx.Cache.Options().Guilds && ctx.Cache.Options().Channels
 
 	if shouldCache {
-		cached, err := ctx.Cache.GetGuildChannels(context.Background(), guildId)
+		cached, err := ctx.Cache.GetGuildChannels(ctx.BaseContext(), guildId)
 		if err != nil && !errors.Is(err, cache.ErrNotFound) {
 			return nil, err
 		} else if err == nil && len(cached) > 0 { // either not cached (more likely), or guild has no channels
@@ -326,7 +326,7 @@ func (ctx *Context) GetGuildChannels(guildId uint64) ([]channel.Channel, error)
 		} // else continue
 	}
 
-	channels, err := rest.GetGuildChannels(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	channels, err := rest.GetGuildChannels(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 
 	if shouldCache && err == nil {
 		go ctx.Cache.ReplaceChannels(context.Background(), guildId, channels)
@@ -336,11 +336,11 @@ func (ctx *Context) GetGuildChannels(guildId uint64) ([]channel.Channel, error)
 }
 
 func (ctx *Context) CreateGuildChannel(guildId uint64, data rest.CreateChannelData) (channel.Channel, error) {
-	return rest.CreateGuildChannel(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	return rest.CreateGuildChannel(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) ModifyGuildChannelPositions(guildId uint64, positions []rest.Position) error {
-	return rest.ModifyGuildChannelPositions(context.Background(), ctx.Token, ctx.RateLimiter, guildId, positions)
+	return rest.ModifyGuildChannelPositions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, positions)
 }
 
 func (ctx *Context) GetGuildMember(guildId, userId uint64) (member.Member, error) {
@@ -348,7 +348,7 @@ func (ctx *Context) GetGuildMember(guildId, userId uint64) (member.Member, error
 	cacheUsers := ctx.Cache.Options().Users
 
 	if cacheGuilds && cacheUsers {
-		m, err := ctx.Cache.GetMember(context.Background(), guildId, userId)
+		m, err := ctx.Cache.GetMember(ctx.BaseContext(), guildId, userId)
 		if err == nil {
 			return m, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -356,7 +356,7 @@ func (ctx *Context) GetGuildMember(guildId, userId uint64) (member.Member, error
 		} // else, continue
 	}
 
-	member, err := rest.GetGuildMember(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId)
+	member, err := rest.GetGuildMember(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, userId)
 
 	if cacheGuilds && err == nil {
 		go ctx.Cache.StoreMember(context.Background(), member, guildId)
@@ -366,7 +366,7 @@ func (ctx *Context) GetGuildMember(guildId, userId uint64) (member.Member, error
 }
 
 func (ctx *Context) SearchGuildMembers(guildId uint64, data rest.SearchGuildMembersData) ([]member.Member, error) {
-	members, err := rest.SearchGuildMembers(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	members, err := rest.SearchGuildMembers(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 	if err == nil {
 		go ctx.Cache.StoreMembers(context.Background(), members, guildId)
 	}
@@ -375,7 +375,7 @@ func (ctx *Context) SearchGuildMembers(guildId uint64, data rest.SearchGuildMemb
 }
 
 func (ctx *Context) ListGuildMembers(guildId uint64, data rest.ListGuildMembersData) ([]member.Member, error) {
-	members, err := rest.ListGuildMembers(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	members, err := rest.ListGuildMembers(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 	if err == nil {
 		go ctx.Cache.StoreMembers(context.Background(), members, guildId)
 	}
@@ -384,45 +384,45 @@ func (ctx *Context) ListGuildMembers(guildId uint64, data rest.ListGuildMembersD
 }
 
 func (ctx *Context) ModifyGuildMember(guildId, userId uint64, data rest.ModifyGuildMemberData) error {
-	return rest.ModifyGuildMember(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId, data)
+	return rest.ModifyGuildMember(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, userId, data)
 }
 
 func (ctx *Context) ModifyCurrentUserNick(guildId uint64, nick string) error {
-	return rest.ModifyCurrentUserNick(context.Background(), ctx.Token, ctx.RateLimiter, guildId, nick)
+	return rest.ModifyCurrentUserNick(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, nick)
 }
 
 func (ctx *Context) AddGuildMemberRole(guildId, userId, roleId uint64) error {
-	return rest.AddGuildMemberRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId, roleId)
+	return rest.AddGuildMemberRole(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, userId, roleId)
 }
 
 func (ctx *Context) RemoveGuildMemberRole(guildId, userId, roleId uint64) error {
-	return rest.RemoveGuildMemberRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId, roleId)
+	return rest.RemoveGuildMemberRole(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, userId, roleId)
 }
 
 func (ctx *Context) RemoveGuildMember(guildId, userId uint64) error {
-	return rest.RemoveGuildMember(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId)
+	return rest.RemoveGuildMember(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, userId)
 }
 
 func (ctx *Context) GetGuildBans(guildId uint64, data rest.GetGuildBansData) ([]guild.Ban, error) {
-	return rest.GetGuildBans(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	return rest.GetGuildBans(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) GetGuildBan(guildId, userId uint64) (guild.Ban, error) {
-	return rest.GetGuildBan(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId)
+	return rest.GetGuildBan(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, userId)
 }
 
 func (ctx *Context) CreateGuildBan(guildId, userId uint64, data rest.CreateGuildBanData) error {
-	return rest.CreateGuildBan(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId, data)
+	return rest.CreateGuildBan(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, userId, data)
 }
 
 func (ctx *Context) RemoveGuildBan(guildId, userId uint64) error {
-	return rest.RemoveGuildBan(context.Background(), ctx.Token, ctx.RateLimiter, guildId, userId)
+	return rest.RemoveGuildBan(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, userId)
 }
 
 func (ctx *Context) GetGuildRoles(guildId uint64) ([]guild.Role, error) {
 	shouldCache := ctx.Cache.Options().Guilds && ctx.Cache.Options().Roles
 	if shouldCache {
-		cached, err := ctx.Cache.GetGuildRoles(context.Background(), guildId)
+		cached, err := ctx.Cache.GetGuildRoles(ctx.BaseContext(), guildId)
 		if err != nil && !errors.Is(err, cache.ErrNotFound) {
 			return nil, err
 		} else if err == nil && len(cached) > 0 { // either not cached (more likely), or guild has no roles
@@ -430,7 +430,7 @@ func (ctx *Context) GetGuildRoles(guildId uint64) ([]guild.Role, error) {
 		} // else continue
 	}
 
-	roles, err := rest.GetGuildRoles(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	roles, err := rest.GetGuildRoles(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 
 	if shouldCache && err == nil {
 		go ctx.Cache.StoreRoles(context.Background(), roles, guildId)
@@ -440,88 +440,88 @@ func (ctx *Context) GetGuildRoles(guildId uint64) ([]guild.Role, error) {
 }
 
 func (ctx *Context) CreateGuildRole(guildId uint64, data rest.GuildRoleData) (guild.Role, error) {
-	return rest.CreateGuildRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	return rest.CreateGuildRole(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) ModifyGuildRolePositions(guildId uint64, positions []rest.Position) ([]guild.Role, error) {
-	return rest.ModifyGuildRolePositions(context.Background(), ctx.Token, ctx.RateLimiter, guildId, positions)
+	return rest.ModifyGuildRolePositions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, positions)
 }
 
 func (ctx *Context) ModifyGuildRole(guildId, roleId uint64, data rest.GuildRoleData) (guild.Role, error) {
-	return rest.ModifyGuildRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, roleId, data)
+	return rest.ModifyGuildRole(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, roleId, data)
 }
 
 func (ctx *Context) DeleteGuildRole(guildId, roleId uint64) error {
-	return rest.DeleteGuildRole(context.Background(), ctx.Token, ctx.RateLimiter, guildId, roleId)
+	return rest.DeleteGuildRole(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, roleId)
 }
 
 func (ctx *Context) GetGuildPruneCount(guildId uint64, days int) (int, error) {
-	return rest.GetGuildPruneCount(context.Background(), ctx.Token, ctx.RateLimiter, guildId, days)
+	return rest.GetGuildPruneCount(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, days)
 }
 
 // computePruneCount = whether 'pruned' is returned, discouraged for large guilds
 func (ctx *Context) BeginGuildPrune(guildId uint64, days int, computePruneCount bool) error {
-	return rest.BeginGuildPrune(context.Background(), ctx.Token, ctx.RateLimiter, guildId, days, computePruneCount)
+	return rest.BeginGuildPrune(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, days, computePruneCount)
 }
 
 func (ctx *Context) GetGuildVoiceRegions(guildId uint64) ([]guild.VoiceRegion, error) {
-	return rest.GetGuildVoiceRegions(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.GetGuildVoiceRegions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetGuildInvites(guildId uint64) ([]invite.InviteMetadata, error) {
-	return rest.GetGuildInvites(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.GetGuildInvites(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetGuildIntegrations(guildId uint64) ([]integration.Integration, error) {
-	return rest.GetGuildIntegrations(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.GetGuildIntegrations(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) CreateGuildIntegration(guildId uint64, data rest.CreateIntegrationData) error {
-	return rest.CreateGuildIntegration(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	return rest.CreateGuildIntegration(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) ModifyGuildIntegration(guildId, integrationId uint64, data rest.ModifyIntegrationData) error {
-	return rest.ModifyGuildIntegration(context.Background(), ctx.Token, ctx.RateLimiter, guildId, integrationId, data)
+	return rest.ModifyGuildIntegration(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, integrationId, data)
 }
 
 func (ctx *Context) DeleteGuildIntegration(guildId, integrationId uint64) error {
-	return rest.DeleteGuildIntegration(context.Background(), ctx.Token, ctx.RateLimiter, guildId, integrationId)
+	return rest.DeleteGuildIntegration(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, integrationId)
 }
 
 func (ctx *Context) SyncGuildIntegration(guildId, integrationId uint64) error {
-	return rest.SyncGuildIntegration(context.Background(), ctx.Token, ctx.RateLimiter, guildId, integrationId)
+	return rest.SyncGuildIntegration(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, integrationId)
 }
 
 func (ctx *Context) GetGuildEmbed(guildId uint64) (guild.GuildWidget, error) {
-	return rest.GetGuildWidget(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.GetGuildWidget(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) ModifyGuildEmbed(guildId uint64, data guild.GuildEmbed) (guild.GuildEmbed, error) {
-	return rest.ModifyGuildEmbed(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	return rest.ModifyGuildEmbed(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 // returns invite object with only "code" and "uses" fields
 func (ctx *Context) GetGuildVanityUrl(guildId uint64) (invite.Invite, error) {
-	return rest.GetGuildVanityURL(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.GetGuildVanityURL(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetInvite(inviteCode string, withCounts bool) (invite.Invite, error) {
-	return rest.GetInvite(context.Background(), ctx.Token, ctx.RateLimiter, inviteCode, withCounts)
+	return rest.GetInvite(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, inviteCode, withCounts)
 }
 
 func (ctx *Context) DeleteInvite(inviteCode string) (invite.Invite, error) {
-	return rest.DeleteInvite(context.Background(), ctx.Token, ctx.RateLimiter, inviteCode)
+	return rest.DeleteInvite(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, inviteCode)
 }
 
 func (ctx *Context) GetCurrentUser() (user.User, error) {
-	cached, err := ctx.Cache.GetSelf(context.Background())
+	cached, err := ctx.Cache.GetSelf(ctx.BaseContext())
 	if err == nil {
 		return cached, nil
 	} else if !errors.Is(err, cache.ErrNotFound) {
 		return user.User{}, err
 	} // else, continue
 
-	self, err := rest.GetCurrentUser(context.Background(), ctx.Token, ctx.RateLimiter)
+	self, err := rest.GetCurrentUser(ctx.BaseContext(), ctx.Token, ctx.RateLimiter)
 
 	if err == nil {
 		go ctx.Cache.StoreSelf(context.Background(), self)
@@ -534,7 +534,7 @@ func (ctx *Context) GetUser(userId uint64) (user.User, error) {
 	shouldCache := ctx.Cache.Options().Users
 
 	if shouldCache {
-		cached, err := ctx.Cache.GetUser(context.Background(), userId)
+		cached, err := ctx.Cache.GetUser(ctx.BaseContext(), userId)
 		if err == nil {
 			return cached, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
@@ -542,7 +542,7 @@ func (ctx *Context) GetUser(userId uint64) (user.User, error) {
 		} // else, continue
 	}
 
-	user, err := rest.GetUser(context.Background(), ctx.Token, ctx.RateLimiter, userId)
+	user, err := rest.GetUser(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, userId)
 
 	if shouldCache && err == nil {
 		go ctx.Cache.StoreUser(context.Background(), user)
@@ -552,107 +552,107 @@ func (ctx *Context) GetUser(userId uint64) (user.User, error) {
 }
 
 func (ctx *Context) ModifyCurrentUser(data rest.ModifyUserData) (user.User, error) {
-	return rest.ModifyCurrentUser(context.Background(), ctx.Token, ctx.RateLimiter, data)
+	return rest.ModifyCurrentUser(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, data)
 }
 
 func (ctx *Context) GetCurrentUserGuilds(data rest.CurrentUserGuildsData) ([]guild.Guild, error) {
-	return rest.GetCurrentUserGuilds(context.Background(), ctx.Token, ctx.RateLimiter, data)
+	return rest.GetCurrentUserGuilds(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, data)
 }
 
 func (ctx *Context) LeaveGuild(guildId uint64) error {
-	return rest.LeaveGuild(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.LeaveGuild(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) CreateDM(recipientId uint64) (channel.Channel, error) {
-	return rest.CreateDM(context.Background(), ctx.Token, ctx.RateLimiter, recipientId)
+	return rest.CreateDM(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, recipientId)
 }
 
 func (ctx *Context) GetUserConnections() ([]integration.Connection, error) {
-	return rest.GetUserConnections(context.Background(), ctx.Token, ctx.RateLimiter)
+	return rest.GetUserConnections(ctx.BaseContext(), ctx.Token, ctx.RateLimiter)
 }
 
 // GetGuildVoiceRegions should be preferred, as it returns VIP servers if available to the guild
 func (ctx *Context) ListVoiceRegions() ([]guild.VoiceRegion, error) {
-	return rest.ListVoiceRegions(context.Background(), ctx.Token)
+	return rest.ListVoiceRegions(ctx.BaseContext(), ctx.Token)
 }
 
 func (ctx *Context) CreateWebhook(channelId uint64, data rest.WebhookData) (guild.Webhook, error) {
-	return rest.CreateWebhook(context.Background(), ctx.Token, ctx.RateLimiter, channelId, data)
+	return rest.CreateWebhook(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId, data)
 }
 
 func (ctx *Context) GetChannelWebhooks(channelId uint64) ([]guild.Webhook, error) {
-	return rest.GetChannelWebhooks(context.Background(), ctx.Token, ctx.RateLimiter, channelId)
+	return rest.GetChannelWebhooks(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, channelId)
 }
 
 func (ctx *Context) GetGuildWebhooks(guildId uint64) ([]guild.Webhook, error) {
-	return rest.GetGuildWebhooks(context.Background(), ctx.Token, ctx.RateLimiter, guildId)
+	return rest.GetGuildWebhooks(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId)
 }
 
 func (ctx *Context) GetWebhook(webhookId uint64) (guild.Webhook, error) {
-	return rest.GetWebhook(context.Background(), ctx.Token, ctx.RateLimiter, webhookId)
+	return rest.GetWebhook(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, webhookId)
 }
 
 func (ctx *Context) ModifyWebhook(webhookId uint64, data rest.ModifyWebhookData) (guild.Webhook, error) {
-	return rest.ModifyWebhook(context.Background(), ctx.Token, ctx.RateLimiter, webhookId, data)
+	return rest.ModifyWebhook(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, webhookId, data)
 }
 
 func (ctx *Context) DeleteWebhook(webhookId uint64) error {
-	return rest.DeleteWebhook(context.Background(), ctx.Token, ctx.RateLimiter, webhookId)
+	return rest.DeleteWebhook(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, webhookId)
 }
 
 // if wait=true, a message object will be returned
 func (ctx *Context) ExecuteWebhook(webhookId uint64, webhookToken string, wait bool, data rest.WebhookBody) (*message.Message, error) {
-	return rest.ExecuteWebhook(context.Background(), webhookToken, ctx.RateLimiter, webhookId, wait, data)
+	return rest.ExecuteWebhook(ctx.BaseContext(), webhookToken, ctx.RateLimiter, webhookId, wait, data)
 }
 
 func (ctx *Context) GetGuildAuditLog(guildId uint64, data rest.GetGuildAuditLogData) (auditlog.AuditLog, error) {
-	return rest.GetGuildAuditLog(context.Background(), ctx.Token, ctx.RateLimiter, guildId, data)
+	return rest.GetGuildAuditLog(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, guildId, data)
 }
 
 func (ctx *Context) GetGlobalCommands(applicationId uint64) ([]interaction.ApplicationCommand, error) {
-	return rest.GetGlobalCommands(context.Background(), ctx.Token, ctx.RateLimiter, applicationId)
+	return rest.GetGlobalCommands(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId)
 }
 
 func (ctx *Context) CreateGlobalCommand(applicationId uint64, data rest.CreateCommandData) (interaction.ApplicationCommand, error) {
-	return rest.CreateGlobalCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, data)
+	return rest.CreateGlobalCommand(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, data)
 }
 
 func (ctx *Context) ModifyGlobalCommand(applicationId, commandId uint64, data rest.CreateCommandData) (interaction.ApplicationCommand, error) {
-	return rest.ModifyGlobalCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, commandId, data)
+	return rest.ModifyGlobalCommand(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, commandId, data)
 }
 
 func (ctx *Context) DeleteGlobalCommand(applicationId, commandId uint64) error {
-	return rest.DeleteGlobalCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, commandId)
+	return rest.DeleteGlobalCommand(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, commandId)
 }
 
 func (ctx *Context) GetGuildCommands(applicationId, guildId uint64) ([]interaction.ApplicationCommand, error) {
-	return rest.GetGuildCommands(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId)
+	return rest.GetGuildCommands(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, guildId)
 }
 
 func (ctx *Context) CreateGuildCommand(applicationId, guildId uint64, data rest.CreateCommandData) (interaction.ApplicationCommand, error) {
-	return rest.CreateGuildCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, data)
+	return rest.CreateGuildCommand(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, guildId, data)
 }
 
 func (ctx *Context) ModifyGuildCommand(applicationId, guildId, commandId uint64, data rest.CreateCommandData) (interaction.ApplicationCommand, error) {
-	return rest.ModifyGuildCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId, data)
+	return rest.ModifyGuildCommand(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId, data)
 }
 
 func (ctx *Context) DeleteGuildCommand(applicationId, guildId, commandId uint64) error {
-	return rest.DeleteGuildCommand(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId)
+	return rest.DeleteGuildCommand(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId)
 }
 
 func (ctx *Context) GetCommandPermissions(applicationId, guildId, commandId uint64) (rest.CommandWithPermissionsData, error) {
-	return rest.GetCommandPermissions(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId)
+	return rest.GetCommandPermissions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId)
 }
 
 func (ctx *Context) GetBulkCommandPermissions(applicationId, guildId uint64) ([]rest.CommandWithPermissionsData, error) {
-	return rest.GetBulkCommandPermissions(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId)
+	return rest.GetBulkCommandPermissions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, guildId)
 }
 
 func (ctx *Context) EditCommandPermissions(applicationId, guildId, commandId uint64, data rest.CommandWithPermissionsData) (rest.CommandWithPermissionsData, error) {
-	return rest.EditCommandPermissions(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId, data)
+	return rest.EditCommandPermissions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, guildId, commandId, data)
 }
 
 func (ctx *Context) EditBulkCommandPermissions(applicationId, guildId uint64, data []rest.CommandWithPermissionsData) ([]rest.CommandWithPermissionsData, error) {
-	return rest.EditBulkCommandPermissions(context.Background(), ctx.Token, ctx.RateLimiter, applicationId, guildId, data)
+	return rest.EditBulkCommandPermissions(ctx.BaseContext(), ctx.Token, ctx.RateLimiter, applicationId, guildId, data)
 }